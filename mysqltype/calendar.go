@@ -0,0 +1,216 @@
+package mysqltype
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+)
+
+// Calendar decides business days
+type Calendar interface {
+	IsBusinessDay(d Date) bool
+}
+
+// HolidayCalendar Calendar of weekends and a set of holidays
+type HolidayCalendar struct {
+	weekends [7]bool
+	holidays map[Date]string
+}
+
+// NewHolidayCalendar Create new HolidayCalendar from weekends and holidays
+// Weekdays out of time.Sunday to time.Saturday are ignored.
+//
+//	cal := mysqltype.NewHolidayCalendar([]time.Weekday{time.Saturday, time.Sunday}, holidays)
+func NewHolidayCalendar(weekends []time.Weekday, holidays []Date) *HolidayCalendar {
+	c := &HolidayCalendar{holidays: map[Date]string{}}
+	for _, w := range weekends {
+		if time.Sunday <= w && w <= time.Saturday {
+			c.weekends[w] = true
+		}
+	}
+	for _, d := range holidays {
+		c.AddHoliday(d, "")
+	}
+	return c
+}
+
+// LoadICalendar Create new HolidayCalendar from weekends and all-day events of iCalendar (RFC 5545)
+// Each VEVENT is a holiday from DTSTART until the day before DTEND, and SUMMARY is used as its name.
+// Recurrence rules are not expanded.
+func LoadICalendar(r io.Reader, weekends []time.Weekday) (*HolidayCalendar, error) {
+	c := NewHolidayCalendar(weekends, nil)
+	lines, err := unfoldICalendar(r)
+	if err != nil {
+		return nil, err
+	}
+	var inEvent bool
+	var start, end NullDate
+	var summary string
+	for _, line := range lines {
+		name, value, err := parseICalendarLine(line)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case name == "BEGIN" && value == "VEVENT":
+			inEvent = true
+			start, end, summary = NullDate{}, NullDate{}, ""
+		case name == "END" && value == "VEVENT":
+			if !inEvent || !start.Valid {
+				return nil, ErrInvalidFormat
+			}
+			inEvent = false
+			last := start.Date
+			if end.Valid && end.Date.After(start.Date) {
+				last = end.Date.AddDate(0, 0, -1)
+			}
+			for d := start.Date; !d.After(last); d = d.AddDate(0, 0, 1) {
+				c.AddHoliday(d, summary)
+			}
+		case !inEvent:
+		case name == "DTSTART", name == "DTEND":
+			if len(value) < 8 {
+				return nil, ErrInvalidFormat
+			}
+			t, err := time.Parse("20060102", value[:8])
+			if err != nil {
+				return nil, ErrInvalidFormat
+			}
+			if name == "DTSTART" {
+				start = NewNullDate(NewDateFromTime(t))
+			} else {
+				end = NewNullDate(NewDateFromTime(t))
+			}
+		case name == "SUMMARY":
+			summary = unescapeICalendarText(value)
+		}
+	}
+	if inEvent {
+		return nil, ErrInvalidFormat
+	}
+	return c, nil
+}
+
+// unfoldICalendar split r into content lines, joining folded lines
+func unfoldICalendar(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+// parseICalendarLine split content line into property name without parameters and value
+func parseICalendarLine(line string) (string, string, error) {
+	i := strings.Index(line, ":")
+	if i < 0 {
+		return "", "", ErrInvalidFormat
+	}
+	name := line[:i]
+	if j := strings.Index(name, ";"); j >= 0 {
+		name = name[:j]
+	}
+	return strings.ToUpper(name), line[i+1:], nil
+}
+
+var iCalendarTextReplacer = strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`)
+
+func unescapeICalendarText(s string) string {
+	return iCalendarTextReplacer.Replace(s)
+}
+
+// AddHoliday add d as a holiday named name
+func (c *HolidayCalendar) AddHoliday(d Date, name string) {
+	c.holidays[d] = name
+}
+
+// IsHoliday reports whether d is a holiday, ignoring weekends
+func (c *HolidayCalendar) IsHoliday(d Date) bool {
+	_, ok := c.holidays[d]
+	return ok
+}
+
+// HolidayName name of the holiday d
+// It reports false if d is not a holiday.
+func (c *HolidayCalendar) HolidayName(d Date) (string, bool) {
+	name, ok := c.holidays[d]
+	return name, ok
+}
+
+// IsBusinessDay reports whether d is neither a weekend nor a holiday
+func (c *HolidayCalendar) IsBusinessDay(d Date) bool {
+	return !c.weekends[d.Weekday()] && !c.IsHoliday(d)
+}
+
+// IsBusinessDay reports whether dt is a business day in cal
+func (dt Date) IsBusinessDay(cal Calendar) bool {
+	return cal.IsBusinessDay(dt)
+}
+
+// NextBusinessDay first business day after dt in cal
+// It returns MaxDate if no business day is found.
+func (dt Date) NextBusinessDay(cal Calendar) Date {
+	return dt.AddBusinessDays(1, cal)
+}
+
+// PreviousBusinessDay last business day before dt in cal
+// It returns MinDate if no business day is found.
+func (dt Date) PreviousBusinessDay(cal Calendar) Date {
+	return dt.AddBusinessDays(-1, cal)
+}
+
+// AddBusinessDays date n business days after dt in cal, or before if n is negative
+// dt itself is not counted, so AddBusinessDays(0, cal) returns dt even if it is not a business day.
+// The result is limited to MinDate and MaxDate.
+func (dt Date) AddBusinessDays(n int, cal Calendar) Date {
+	step := 1
+	if n < 0 {
+		n, step = -n, -1
+	}
+	min, max := MinDate(), MaxDate()
+	d := dt
+	for n > 0 {
+		if (step > 0 && !d.Before(max)) || (step < 0 && !d.After(min)) {
+			return d
+		}
+		d = d.AddDate(0, 0, step)
+		if cal.IsBusinessDay(d) {
+			n--
+		}
+	}
+	return d
+}
+
+// BusinessDaysBetween number of business days in cal after dt until u inclusive
+// It is negative if u is before dt, so that dt.AddBusinessDays(dt.BusinessDaysBetween(u, cal), cal) is u for any business day u.
+func (dt Date) BusinessDaysBetween(u Date, cal Calendar) int {
+	if u.Before(dt) {
+		return -countBusinessDays(u, dt.AddDate(0, 0, -1), cal)
+	}
+	return countBusinessDays(dt.AddDate(0, 0, 1), u, cal)
+}
+
+// countBusinessDays number of business days in cal from first until last inclusive
+func countBusinessDays(first, last Date, cal Calendar) int {
+	n := 0
+	for d := first; !d.After(last); d = d.AddDate(0, 0, 1) {
+		if cal.IsBusinessDay(d) {
+			n++
+		}
+	}
+	return n
+}
+
+var _ Calendar = &HolidayCalendar{}
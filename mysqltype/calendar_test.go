@@ -0,0 +1,108 @@
+package mysqltype
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestCalendar() *HolidayCalendar {
+	// 2018-08-13 to 2018-08-15 are summer holidays
+	return NewHolidayCalendar(
+		[]time.Weekday{time.Saturday, time.Sunday},
+		[]Date{NewDate(2018, 8, 13), NewDate(2018, 8, 14), NewDate(2018, 8, 15)},
+	)
+}
+
+func TestDateIsBusinessDay(t *testing.T) {
+	t.Parallel()
+	cal := newTestCalendar()
+	assert.True(t, NewDate(2018, 8, 10).IsBusinessDay(cal))
+	assert.False(t, NewDate(2018, 8, 11).IsBusinessDay(cal))
+	assert.False(t, NewDate(2018, 8, 13).IsBusinessDay(cal))
+	assert.True(t, NewDate(2018, 8, 16).IsBusinessDay(cal))
+	assert.True(t, NewDate(2018, 8, 11).IsBusinessDay(NewHolidayCalendar(nil, nil)))
+	assert.True(t, NewDate(2018, 8, 11).IsBusinessDay(NewHolidayCalendar([]time.Weekday{-1, 7}, nil)))
+}
+
+func TestDateAddBusinessDays(t *testing.T) {
+	t.Parallel()
+	cal := newTestCalendar()
+	tests := []struct {
+		src      Date
+		n        int
+		expected Date
+	}{
+		{NewDate(2018, 8, 10), 0, NewDate(2018, 8, 10)},
+		{NewDate(2018, 8, 11), 0, NewDate(2018, 8, 11)},
+		{NewDate(2018, 8, 10), 1, NewDate(2018, 8, 16)},
+		{NewDate(2018, 8, 11), 1, NewDate(2018, 8, 16)},
+		{NewDate(2018, 8, 9), 3, NewDate(2018, 8, 17)},
+		{NewDate(2018, 8, 16), -1, NewDate(2018, 8, 10)},
+		{NewDate(2018, 8, 12), -2, NewDate(2018, 8, 9)},
+	}
+	for _, tt := range tests {
+		actual := tt.src.AddBusinessDays(tt.n, cal)
+		assert.Equal(t, tt.expected, actual, "%v + %d", tt.src, tt.n)
+		if actual.IsBusinessDay(cal) {
+			assert.Equal(t, tt.n, tt.src.BusinessDaysBetween(actual, cal), "%v - %v", actual, tt.src)
+		}
+	}
+
+	never := NewHolidayCalendar([]time.Weekday{0, 1, 2, 3, 4, 5, 6}, nil)
+	assert.Equal(t, MaxDate(), NewDate(9999, 12, 1).NextBusinessDay(never))
+	assert.Equal(t, MinDate(), NewDate(1000, 2, 1).PreviousBusinessDay(never))
+}
+
+func TestDateBusinessDaysBetween(t *testing.T) {
+	t.Parallel()
+	cal := newTestCalendar()
+	assert.Equal(t, 0, NewDate(2018, 8, 10).BusinessDaysBetween(NewDate(2018, 8, 10), cal))
+	assert.Equal(t, 0, NewDate(2018, 8, 10).BusinessDaysBetween(NewDate(2018, 8, 15), cal))
+	assert.Equal(t, 8, NewDate(2018, 8, 1).BusinessDaysBetween(NewDate(2018, 8, 16), cal))
+	assert.Equal(t, -8, NewDate(2018, 8, 16).BusinessDaysBetween(NewDate(2018, 8, 1), cal))
+}
+
+func TestLoadICalendar(t *testing.T) {
+	t.Parallel()
+	src := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20180813",
+		"DTEND;VALUE=DATE:20180816",
+		"SUMMARY:Summer\\, holidays",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20181228",
+		"SUMMARY:Year end",
+		"  party",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+	cal, err := LoadICalendar(strings.NewReader(src), []time.Weekday{time.Sunday})
+	assert.NoError(t, err)
+
+	name, ok := cal.HolidayName(NewDate(2018, 8, 15))
+	assert.True(t, ok)
+	assert.Equal(t, "Summer, holidays", name)
+	assert.False(t, cal.IsHoliday(NewDate(2018, 8, 16)))
+
+	name, ok = cal.HolidayName(NewDate(2018, 12, 28))
+	assert.True(t, ok)
+	assert.Equal(t, "Year end party", name)
+	assert.False(t, cal.IsHoliday(NewDate(2018, 12, 29)))
+	assert.True(t, cal.IsBusinessDay(NewDate(2018, 12, 29)))
+
+	for _, src := range []string{
+		"BEGIN:VEVENT\r\nSUMMARY:no start\r\nEND:VEVENT",
+		"BEGIN:VEVENT\r\nDTSTART:2018\r\nEND:VEVENT",
+		"BEGIN:VEVENT\r\nDTSTART:20180813",
+		"invalid line",
+	} {
+		_, err := LoadICalendar(strings.NewReader(src), nil)
+		assert.Equal(t, ErrInvalidFormat, err, src)
+	}
+}
@@ -0,0 +1,201 @@
+package mysqltype
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// conformanceCase value to round trip
+// Marshallers may reject a value with invalid set, but what they write must be read back.
+type conformanceCase struct {
+	name    string
+	value   interface{}
+	invalid bool
+}
+
+// conformanceCases values of every type to round trip
+var conformanceCases = []conformanceCase{
+	{"Date", NewDate(2018, 8, 20), false},
+	{"Date/min", MinDate(), false},
+	{"Date/max", MaxDate(), false},
+	{"Date/zero", Date{}, true},
+	{"DateTime", NewDateTime(2018, 8, 20, 10, 20, 30, 123456000, time.UTC), false},
+	{"DateTime/min", MinDateTime(), false},
+	{"DateTime/max", MaxDateTime(), false},
+	{"DateTime/zero", DateTime{}, true},
+	{"DateTime0", NewDateTime0(NewDateTime(2018, 8, 20, 10, 20, 30, 0, time.UTC)), false},
+	{"DateTime1", NewDateTime1(NewDateTime(2018, 8, 20, 10, 20, 30, 100000000, time.UTC)), false},
+	{"DateTime2", NewDateTime2(NewDateTime(2018, 8, 20, 10, 20, 30, 120000000, time.UTC)), false},
+	{"DateTime3", NewDateTime3(NewDateTime(2018, 8, 20, 10, 20, 30, 123000000, time.UTC)), false},
+	{"DateTime4", NewDateTime4(NewDateTime(2018, 8, 20, 10, 20, 30, 123400000, time.UTC)), false},
+	{"DateTime5", NewDateTime5(NewDateTime(2018, 8, 20, 10, 20, 30, 123450000, time.UTC)), false},
+	{"DateTime6", NewDateTime6(NewDateTime(2018, 8, 20, 10, 20, 30, 123456000, time.UTC)), false},
+	{"Timestamp", NewTimestamp(2018, 8, 20, 10, 20, 30, 123456000, time.UTC), false},
+	{"Timestamp/zero", Timestamp{}, true},
+	{"LocalDateTime", NewLocalDateTime(2018, 8, 20, 10, 20, 30, 123456000), false},
+	{"LocalDateTime/zero", LocalDateTime{}, true},
+	{"Time", NewTime(10, 20, 30, 123456000), false},
+	{"Time/negative", NewTimeFromDuration(-838*time.Hour - 59*time.Minute - 59*time.Second), false},
+	{"Year", NewYear(2018), false},
+	{"NullDate", NewNullDate(NewDate(2018, 8, 20)), false},
+	{"NullDate/null", NullDate{}, false},
+	{"NullDateTime", NewNullDateTime(NewDateTime(2018, 8, 20, 10, 20, 30, 123456000, time.UTC)), false},
+	{"NullDateTime/null", NullDateTime{}, false},
+	{"NullTime", NewNullTime(NewTime(10, 20, 30, 0)), false},
+	{"NullTime/null", NullTime{}, false},
+	{"NullYear", NewNullYear(NewYear(2018)), false},
+	{"NullYear/null", NullYear{}, false},
+	{"NullTimestamp", NewNullTimestamp(NewTimestamp(2018, 8, 20, 10, 20, 30, 0, time.UTC)), false},
+	{"NullTimestamp/null", NullTimestamp{}, false},
+	{"NullLocalDateTime", NewNullLocalDateTime(NewLocalDateTime(2018, 8, 20, 10, 20, 30, 0)), false},
+	{"NullLocalDateTime/null", NullLocalDateTime{}, false},
+	{"JSON", JSON{src: []byte(`{"a":[1,2],"b":null}`)}, false},
+	{"JSON/null", JSON{}, false},
+	{"Decimal", NewDecimal(-123456789, 4), false},
+	{"NullDecimal", NewNullDecimal(NewDecimal(150, 2)), false},
+	{"NullDecimal/null", NullDecimal{}, false},
+	{"Currency", Currency{src: "KWD"}, false},
+	{"Money", NewMoney(NewDecimal(-1050, 2), Currency{src: "USD"}), false},
+	{"Period", NewPeriod(1, -2, 3), false},
+	{"DateRange", NewDateRange(NewDate(2018, 8, 20), NewDate(2018, 9, 1)), false},
+	{"DateTimeRange", NewDateTimeRangeFrom(NewDateTime(2018, 8, 20, 10, 20, 30, 0, time.UTC)), false},
+}
+
+// conformanceEncodings round trip v into dst
+// They report false if the type of v does not support the encoding,
+// and errors of marshalling and unmarshalling separately.
+var conformanceEncodings = []struct {
+	name      string
+	roundTrip func(v, dst interface{}) (ok bool, marshalErr, unmarshalErr error)
+}{
+	{"Text", func(v, dst interface{}) (bool, error, error) {
+		m, ok := v.(encoding.TextMarshaler)
+		u, ok2 := dst.(encoding.TextUnmarshaler)
+		if !ok || !ok2 {
+			return false, nil, nil
+		}
+		data, err := m.MarshalText()
+		if err != nil {
+			return true, err, nil
+		}
+		return true, nil, u.UnmarshalText(data)
+	}},
+	{"Binary", func(v, dst interface{}) (bool, error, error) {
+		m, ok := v.(encoding.BinaryMarshaler)
+		u, ok2 := dst.(encoding.BinaryUnmarshaler)
+		if !ok || !ok2 {
+			return false, nil, nil
+		}
+		data, err := m.MarshalBinary()
+		if err != nil {
+			return true, err, nil
+		}
+		return true, nil, u.UnmarshalBinary(data)
+	}},
+	{"JSON", func(v, dst interface{}) (bool, error, error) {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return true, err, nil
+		}
+		return true, nil, json.Unmarshal(data, dst)
+	}},
+	{"gob", func(v, dst interface{}) (bool, error, error) {
+		buf := &bytes.Buffer{}
+		if err := gob.NewEncoder(buf).Encode(v); err != nil {
+			return true, err, nil
+		}
+		return true, nil, gob.NewDecoder(buf).Decode(dst)
+	}},
+	{"SQL", func(v, dst interface{}) (bool, error, error) {
+		valuer, ok := v.(driver.Valuer)
+		scanner, ok2 := dst.(sql.Scanner)
+		if !ok || !ok2 {
+			return false, nil, nil
+		}
+		value, err := valuer.Value()
+		if err != nil {
+			return true, err, nil
+		}
+		return true, nil, scanner.Scan(value)
+	}},
+}
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+	for _, c := range conformanceCases {
+		assertConformance(t, c)
+	}
+}
+
+// TestConformanceByValue encoding/json and encoding/gob use the marshallers of values, not only of pointers
+func TestConformanceByValue(t *testing.T) {
+	t.Parallel()
+	for _, c := range conformanceCases {
+		assertConformanceByValue(t, c)
+	}
+}
+
+// assertConformance round trip c through every encoding
+// Only invalid values may fail, and only in marshallers with the errors of this package.
+func assertConformance(t *testing.T, c conformanceCase) {
+	for _, e := range conformanceEncodings {
+		dst := reflect.New(reflect.TypeOf(c.value))
+		ok, marshalErr, unmarshalErr := e.roundTrip(c.value, dst.Interface())
+		if !ok {
+			continue
+		}
+		if marshalErr != nil {
+			assert.Truef(t, c.invalid && (errors.Is(marshalErr, ErrOutOfRange) || errors.Is(marshalErr, ErrUnknownEnumValue)),
+				"%s %s: unexpected error: `%v`", c.name, e.name, marshalErr)
+			continue
+		}
+		if !assert.NoError(t, unmarshalErr, "%s %s", c.name, e.name) {
+			continue
+		}
+		assertConformEqual(t, c.value, dst.Elem(), c.name+" "+e.name)
+	}
+}
+
+// assertConformanceByValue marshallers of c.value behave as of its pointer
+func assertConformanceByValue(t *testing.T, c conformanceCase) {
+	v := reflect.ValueOf(c.value)
+	ptr := reflect.New(v.Type())
+	ptr.Elem().Set(v)
+	for _, i := range []reflect.Type{
+		reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem(),
+		reflect.TypeOf((*encoding.BinaryMarshaler)(nil)).Elem(),
+		reflect.TypeOf((*json.Marshaler)(nil)).Elem(),
+		reflect.TypeOf((*driver.Valuer)(nil)).Elem(),
+	} {
+		assert.Equal(t, ptr.Type().Implements(i), v.Type().Implements(i), "%s %s", c.name, i)
+	}
+	expected, expectedErr := json.Marshal(ptr.Interface())
+	actual, actualErr := json.Marshal(c.value)
+	assert.Equal(t, expectedErr == nil, actualErr == nil, c.name)
+	assert.Equal(t, string(expected), string(actual), c.name)
+	if !c.invalid {
+		assert.NoError(t, actualErr, c.name)
+	}
+}
+
+// assertConformEqual compare by Equal method of expected if it has, or by assert.Equal
+func assertConformEqual(t *testing.T, expected interface{}, actual reflect.Value, msg string) bool {
+	equal := reflect.ValueOf(expected).MethodByName("Equal")
+	if !equal.IsValid() || equal.Type().NumIn() != 1 || equal.Type().In(0) != actual.Type() {
+		return assert.Equal(t, expected, actual.Interface(), msg)
+	}
+	if equal.Call([]reflect.Value{actual})[0].Bool() {
+		return true
+	}
+	return assert.Fail(t, "not equal", "%s\nexpected: %v\nactual  : %v", msg, expected, actual.Interface())
+}
@@ -0,0 +1,163 @@
+package mysqltype
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+)
+
+// Currency ISO 4217 currency code such as "USD"
+// https://www.iso.org/iso-4217-currency-codes.html
+// Only registered codes are accepted, and the zero value is no currency.
+type Currency struct {
+	src string `gorm:"type:char(3)"`
+}
+
+// currencyMinorUnits digits after the decimal point of minor unit by ISO 4217 currency code
+// Codes without minor unit such as XAU are not registered.
+var currencyMinorUnits = map[string]int{
+	"AED": 2, "AFN": 2, "ALL": 2, "AMD": 2, "ANG": 2, "AOA": 2, "ARS": 2, "AUD": 2, "AWG": 2, "AZN": 2,
+	"BAM": 2, "BBD": 2, "BDT": 2, "BGN": 2, "BHD": 3, "BIF": 0, "BMD": 2, "BND": 2, "BOB": 2, "BOV": 2,
+	"BRL": 2, "BSD": 2, "BTN": 2, "BWP": 2, "BYN": 2, "BZD": 2, "CAD": 2, "CDF": 2, "CHE": 2, "CHF": 2,
+	"CHW": 2, "CLF": 4, "CLP": 0, "CNY": 2, "COP": 2, "COU": 2, "CRC": 2, "CUP": 2, "CVE": 2, "CZK": 2,
+	"DJF": 0, "DKK": 2, "DOP": 2, "DZD": 2, "EGP": 2, "ERN": 2, "ETB": 2, "EUR": 2, "FJD": 2, "FKP": 2,
+	"GBP": 2, "GEL": 2, "GHS": 2, "GIP": 2, "GMD": 2, "GNF": 0, "GTQ": 2, "GYD": 2, "HKD": 2, "HNL": 2,
+	"HTG": 2, "HUF": 2, "IDR": 2, "ILS": 2, "INR": 2, "IQD": 3, "IRR": 2, "ISK": 0, "JMD": 2, "JOD": 3,
+	"JPY": 0, "KES": 2, "KGS": 2, "KHR": 2, "KMF": 0, "KPW": 2, "KRW": 0, "KWD": 3, "KYD": 2, "KZT": 2,
+	"LAK": 2, "LBP": 2, "LKR": 2, "LRD": 2, "LSL": 2, "LYD": 3, "MAD": 2, "MDL": 2, "MGA": 2, "MKD": 2,
+	"MMK": 2, "MNT": 2, "MOP": 2, "MRU": 2, "MUR": 2, "MVR": 2, "MWK": 2, "MXN": 2, "MXV": 2, "MYR": 2,
+	"MZN": 2, "NAD": 2, "NGN": 2, "NIO": 2, "NOK": 2, "NPR": 2, "NZD": 2, "OMR": 3, "PAB": 2, "PEN": 2,
+	"PGK": 2, "PHP": 2, "PKR": 2, "PLN": 2, "PYG": 0, "QAR": 2, "RON": 2, "RSD": 2, "RUB": 2, "RWF": 0,
+	"SAR": 2, "SBD": 2, "SCR": 2, "SDG": 2, "SEK": 2, "SGD": 2, "SHP": 2, "SLE": 2, "SOS": 2, "SRD": 2,
+	"SSP": 2, "STN": 2, "SVC": 2, "SYP": 2, "SZL": 2, "THB": 2, "TJS": 2, "TMT": 2, "TND": 3, "TOP": 2,
+	"TRY": 2, "TTD": 2, "TWD": 2, "TZS": 2, "UAH": 2, "UGX": 0, "USD": 2, "USN": 2, "UYI": 0, "UYU": 2,
+	"UYW": 4, "UZS": 2, "VED": 2, "VES": 2, "VND": 0, "VUV": 0, "WST": 2, "XAF": 0, "XCD": 2, "XOF": 0,
+	"XPF": 0, "YER": 2, "ZAR": 2, "ZMW": 2, "ZWG": 2,
+}
+
+// RegisterCurrency register currency code with digits after the decimal point of its minor unit
+// Use it for codes added to ISO 4217 after this package, or to override minor units.
+// It returns ErrInvalidFormat unless code is three upper case letters and minorUnits is in [0, 4].
+// It is not safe to call concurrently with other functions, so call it on initialization.
+func RegisterCurrency(code string, minorUnits int) error {
+	if !isCurrencyCode(code) || minorUnits < 0 || minorUnits > 4 {
+		return ErrInvalidFormat
+	}
+	currencyMinorUnits[code] = minorUnits
+	return nil
+}
+
+// NewCurrency Create new Currency of code such as "USD"
+// It returns ErrUnknownCurrency if code is not registered.
+func NewCurrency(code string) (Currency, error) {
+	if _, ok := currencyMinorUnits[code]; !ok {
+		return Currency{}, ErrUnknownCurrency
+	}
+	return Currency{src: code}, nil
+}
+
+// IsZero reports whether c is no currency
+func (c Currency) IsZero() bool {
+	return c.src == ""
+}
+
+// MinorUnits digits after the decimal point of minor unit, e.g. 0 for JPY, 2 for USD and 3 for KWD
+// It returns ErrUnknownCurrency for the zero value.
+func (c Currency) MinorUnits() (int, error) {
+	units, ok := currencyMinorUnits[c.src]
+	if !ok {
+		return 0, ErrUnknownCurrency
+	}
+	return units, nil
+}
+
+// String currency code
+func (c Currency) String() string {
+	return c.src
+}
+
+// UnmarshalText parse currency code as NewCurrency
+// Empty text is the zero value as MarshalText formats it.
+func (c *Currency) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*c = Currency{}
+		return nil
+	}
+	v, err := NewCurrency(string(text))
+	if err != nil {
+		return err
+	}
+	*c = v
+	return nil
+}
+
+// MarshalText format as currency code
+func (c Currency) MarshalText() ([]byte, error) {
+	return []byte(c.src), nil
+}
+
+// UnmarshalJSON parse JSON string of currency code
+func (c *Currency) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return c.UnmarshalText([]byte(s))
+}
+
+// MarshalJSON format as JSON string of currency code
+func (c Currency) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.src)
+}
+
+// GobEncode encode as MarshalText
+func (c Currency) GobEncode() ([]byte, error) {
+	return c.MarshalText()
+}
+
+// GobDecode decode as UnmarshalText
+func (c *Currency) GobDecode(data []byte) error {
+	return c.UnmarshalText(data)
+}
+
+var _ driver.Valuer = Currency{}
+var _ sql.Scanner = &Currency{}
+var _ encoding.TextUnmarshaler = &Currency{}
+var _ encoding.TextMarshaler = Currency{}
+var _ json.Marshaler = Currency{}
+var _ json.Unmarshaler = &Currency{}
+
+// Scan for sql.Scanner
+func (c *Currency) Scan(value interface{}) error {
+	switch src := value.(type) {
+	case []byte:
+		return c.UnmarshalText(src)
+	case string:
+		return c.UnmarshalText([]byte(src))
+	default:
+		return &InvalidValueTypeError{Value: value, Target: "Currency"}
+	}
+}
+
+// Value for driver.Valuer
+// It returns ErrUnknownCurrency for the zero value, so that rows without currency are not saved.
+func (c Currency) Value() (driver.Value, error) {
+	if _, err := c.MinorUnits(); err != nil {
+		return nil, err
+	}
+	return c.src, nil
+}
+
+// isCurrencyCode reports whether code is three upper case letters
+func isCurrencyCode(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for i := 0; i < len(code); i++ {
+		if code[i] < 'A' || code[i] > 'Z' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,85 @@
+package mysqltype
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCurrency(t *testing.T) {
+	t.Parallel()
+	for code, expected := range map[string]int{"JPY": 0, "USD": 2, "EUR": 2, "KWD": 3, "CLF": 4} {
+		c, err := NewCurrency(code)
+		assert.NoError(t, err, code)
+		assert.Equal(t, code, c.String())
+		units, err := c.MinorUnits()
+		assert.NoError(t, err, code)
+		assert.Equal(t, expected, units, code)
+	}
+	for _, code := range []string{"", "usd", "US", "USDD", "XAU", "ABC"} {
+		_, err := NewCurrency(code)
+		assert.Equal(t, ErrUnknownCurrency, err, code)
+	}
+
+	_, err := Currency{}.MinorUnits()
+	assert.Equal(t, ErrUnknownCurrency, err)
+	assert.True(t, Currency{}.IsZero())
+}
+
+func TestRegisterCurrency(t *testing.T) {
+	assert.NoError(t, RegisterCurrency("XTS", 2))
+	defer delete(currencyMinorUnits, "XTS")
+	c, err := NewCurrency("XTS")
+	assert.NoError(t, err)
+	units, err := c.MinorUnits()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, units)
+
+	assert.Equal(t, ErrInvalidFormat, RegisterCurrency("xts", 2))
+	assert.Equal(t, ErrInvalidFormat, RegisterCurrency("XTS", 5))
+	assert.Equal(t, ErrInvalidFormat, RegisterCurrency("XTS", -1))
+}
+
+func TestCurrencyScan(t *testing.T) {
+	t.Parallel()
+	target := Currency{}
+	assert.NoError(t, target.Scan([]byte("JPY")))
+	assert.Equal(t, "JPY", target.String())
+	assert.NoError(t, target.Scan("USD"))
+	assert.Equal(t, "USD", target.String())
+
+	assert.Equal(t, ErrUnknownCurrency, target.Scan("XXX"))
+	assertInvalidValueType(t, target.Scan(nil))
+	assertInvalidValueType(t, target.Scan(int64(840)))
+}
+
+func TestCurrencyValue(t *testing.T) {
+	t.Parallel()
+	v, err := mustCurrency(t, "KWD").Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "KWD", v)
+	_, err = Currency{}.Value()
+	assert.Equal(t, ErrUnknownCurrency, err)
+}
+
+func TestCurrencyMarshalJSON(t *testing.T) {
+	t.Parallel()
+	actual, err := json.Marshal([]Currency{mustCurrency(t, "USD"), {}})
+	assert.NoError(t, err)
+	assert.Equal(t, `["USD",""]`, string(actual))
+
+	var dst []Currency
+	assert.NoError(t, json.Unmarshal(actual, &dst))
+	assert.Equal(t, []Currency{mustCurrency(t, "USD"), {}}, dst)
+	assert.Equal(t, ErrUnknownCurrency, json.Unmarshal([]byte(`"usd"`), &Currency{}))
+	assert.Error(t, json.Unmarshal([]byte(`840`), &Currency{}))
+}
+
+func mustCurrency(t *testing.T, code string) Currency {
+	c, err := NewCurrency(code)
+	if err != nil {
+		t.Fatalf("invalid currency %q: %v", code, err)
+	}
+	return c
+}
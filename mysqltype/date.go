@@ -5,34 +5,67 @@ import (
 	"database/sql/driver"
 	"encoding"
 	"encoding/json"
+	"encoding/xml"
 	"time"
+
+	"github.com/jinzhu/gorm"
 )
 
 // Date support MySQL Date type
 // https://dev.mysql.com/doc/refman/8.0/en/datetime.html
+// It holds the number of days since 0001-01-01 instead of time.Time,
+// so it is 8 bytes and comparison and field access do not build time.Time.
+// Scan does not allocate, and Value, MarshalText and MarshalJSON allocate only their results.
+// Out of MySQL DATE range, Value and marshallers return OutOfRangeError, so that unmarshallers can read whatever is written.
 type Date struct {
-	src time.Time `gorm:"type:date"`
+	days int32
+	zero bool
 }
 
 // NewDate Create new Date from time.Date
+// Out of range month and day are normalized as time.Date.
 func NewDate(year int, month time.Month, day int) Date {
-	t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
-	return NewDateFromTime(t)
+	if month < time.January || time.December < month || day < 1 || 28 < day {
+		t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		return Date{days: int32(floorDiv(t.Unix(), secondsPerDay) + unixEpochDays)}
+	}
+	return Date{days: int32(daysFromCivil(year, month, day))}
 }
 
 // NewDateFromTime Create new Date from Time
 func NewDateFromTime(t time.Time) Date {
-	return Date{src: time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)}
+	year, month, day := t.Date()
+	return Date{days: int32(daysFromCivil(year, month, day))}
+}
+
+// NewDateChecked Create new Date from time.Date
+// It returns OutOfRangeError if the date is not in MySQL DATE range.
+func NewDateChecked(year int, month time.Month, day int) (Date, error) {
+	dt := NewDate(year, month, day)
+	if err := dt.validate(); err != nil {
+		return Date{}, err
+	}
+	return dt, nil
+}
+
+// NewDateFromTimeChecked Create new Date from Time
+// It returns OutOfRangeError if the date is not in MySQL DATE range.
+func NewDateFromTimeChecked(t time.Time) (Date, error) {
+	dt := NewDateFromTime(t)
+	if err := dt.validate(); err != nil {
+		return Date{}, err
+	}
+	return dt, nil
 }
 
 // MinDate Minimum Date
 func MinDate() Date {
-	return NewDateFromTime(time.Date(1000, 1, 1, 0, 0, 0, 0, time.UTC))
+	return Date{days: minDateDays}
 }
 
 // MaxDate Maximum Date
 func MaxDate() Date {
-	return NewDateFromTime(time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC))
+	return Date{days: maxDateDays}
 }
 
 // NowDate Create Now time for MySQL DataBase
@@ -42,173 +75,431 @@ func NowDate() Date {
 
 // After behavior as time.Time
 func (dt Date) After(u Date) bool {
-	return dt.src.After(u.src)
+	return dt.days > u.days
 }
 
 // Before behavior as time.Time
 func (dt Date) Before(u Date) bool {
-
-	return dt.src.Before(u.src)
+	return dt.days < u.days
 }
 
 // Equal behavior as time.Time
 func (dt Date) Equal(u Date) bool {
-	return dt.src.Equal(u.src)
+	return dt == u
 }
 
 // Time convert to time.Time
 func (dt Date) Time() time.Time {
-	return dt.src
+	return time.Unix((int64(dt.days)-unixEpochDays)*secondsPerDay, 0).UTC()
 }
 
 // AddDate behavior as time.Time
 func (dt Date) AddDate(years int, months int, days int) Date {
-	return NewDateFromTime(dt.src.AddDate(years, months, days))
+	if years == 0 && months == 0 {
+		return Date{days: dt.days + int32(days)}
+	}
+	year, month, day := dt.Date()
+	return NewDate(year+years, month+time.Month(months), day+days)
 }
 
 // Sub  behavior as time.Time
+// The result saturates after about 292 years, so use DaysSince for the number of days.
 func (dt Date) Sub(u Date) time.Duration {
-	return dt.src.Sub(u.src)
+	return dt.Time().Sub(u.Time())
 }
 
 // Add behavior as time.Time
 func (dt Date) Add(d time.Duration) Date {
-	return NewDateFromTime(dt.src.Add(d))
+	return NewDateFromTime(dt.Time().Add(d))
 }
 
 // IsZero behavior as time.Time
 func (dt Date) IsZero() bool {
-	return dt.src.IsZero()
+	return dt.days == 0
+}
+
+// IsMySQLZero reports whether dt is MySQL zero date
+func (dt Date) IsMySQLZero() bool {
+	return dt.zero
+}
+
+// IsValid reports whether dt is in MySQL DATE range
+func (dt Date) IsValid() bool {
+	return minDateDays <= dt.days && dt.days <= maxDateDays
+}
+
+func (dt Date) validate() error {
+	if !dt.zero && !dt.IsValid() {
+		return &OutOfRangeError{Value: dt, Min: MinDate(), Max: MaxDate()}
+	}
+	return nil
+}
+
+// String format as MySQL DATE literal
+func (dt Date) String() string {
+	if dt.zero {
+		return mySQLZeroDate
+	}
+	var buf [len(dateFormatLayout)]byte
+	return string(dt.appendFormat(buf[:0]))
 }
 
 // Date  behavior as time.Time
 func (dt Date) Date() (year int, month time.Month, day int) {
-	return dt.src.Date()
+	return civilFromDays(int64(dt.days))
 }
 
 // Year behavior as time.Time
 func (dt Date) Year() int {
-	return dt.src.Year()
+	year, _, _ := dt.Date()
+	return year
 }
 
 // Month behavior as time.Time
 func (dt Date) Month() time.Month {
-	return dt.src.Month()
+	_, month, _ := dt.Date()
+	return month
 }
 
 // Day behavior as time.Time
 func (dt Date) Day() int {
-	return dt.src.Day()
+	_, _, day := dt.Date()
+	return day
 }
 
 // Weekday behavior as time.Time
 func (dt Date) Weekday() time.Weekday {
-	return dt.src.Weekday()
+	// 0001-01-01 is Monday
+	return time.Weekday((int64(dt.days)%7 + 8) % 7)
 }
 
 // ISOWeek behavior as time.Time
 func (dt Date) ISOWeek() (year int, week int) {
-	return dt.src.ISOWeek()
+	return dt.Time().ISOWeek()
 }
 
 // YearDay behavior as time.Time
 func (dt Date) YearDay() int {
-	return dt.src.YearDay()
+	year, _, _ := dt.Date()
+	return int(int64(dt.days)-daysFromCivil(year, time.January, 1)) + 1
 }
 
 // UnmarshalText behavior as time.Time
 // But only Date
 func (dt *Date) UnmarshalText(text []byte) error {
+	if string(text) == mySQLZeroDate {
+		*dt = MySQLZeroDate()
+		return nil
+	}
 	t := time.Time{}
 
 	if err := t.UnmarshalText(text); err != nil {
 		return err
 	}
-	dt.src = fixTimeToDate(t)
-	return nil
+	return dt.setChecked(t)
 }
 
 // MarshalText behavior as time.Time
 func (dt Date) MarshalText() ([]byte, error) {
-	return dt.src.MarshalText()
+	if err := dt.validate(); err != nil {
+		return nil, err
+	}
+	if dt.zero {
+		return []byte(mySQLZeroDate), nil
+	}
+	return append(dt.appendFormat(make([]byte, 0, len(dateTextSuffix)+len(dateFormatLayout))), dateTextSuffix...), nil
 }
 
 // UnmarshalBinary behavior as time.Time
 // But only Date
 func (dt *Date) UnmarshalBinary(data []byte) error {
+	if string(data) == mySQLZeroDate {
+		*dt = MySQLZeroDate()
+		return nil
+	}
 	t := time.Time{}
 
 	if err := t.UnmarshalBinary(data); err != nil {
 		return err
 	}
 
-	dt.src = fixTimeToDate(t)
-	return nil
+	return dt.setChecked(t)
 }
 
-// MarshalBinary behavior as time.Time
-func (dt *Date) MarshalBinary() ([]byte, error) {
-	return dt.MarshalBinary()
+// MarshalBinary behavior as time.Time of the midnight of dt in UTC
+// MySQL zero date is encoded as its text, which is never valid binary of time.Time.
+func (dt Date) MarshalBinary() ([]byte, error) {
+	if err := dt.validate(); err != nil {
+		return nil, err
+	}
+	if dt.zero {
+		return []byte(mySQLZeroDate), nil
+	}
+	return dt.Time().MarshalBinary()
 }
 
-// UnmarshalJSON  behavior as time.Time
-// But only Date
+// UnmarshalJSON parse JSON in the format set by SetDateJSONFormat
+// It also accepts "YYYY-MM-DD" and RFC 3339 string as time.Time, and takes the date in its time zone.
 func (dt *Date) UnmarshalJSON(data []byte) error {
-	t := time.Time{}
+	return dt.unmarshalJSONWith(data, dateJSONFormat)
+}
+
+// MarshalJSON format in the format set by SetDateJSONFormat, "YYYY-MM-DD" by default
+func (dt Date) MarshalJSON() ([]byte, error) {
+	return dt.marshalJSONWith(dateJSONFormat)
+}
 
-	if err := t.UnmarshalJSON(data); err != nil {
+func (dt *Date) unmarshalJSONWith(data []byte, f JSONFormat) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if string(data) == `"`+mySQLZeroDate+`"` {
+		*dt = MySQLZeroDate()
+		return nil
+	}
+	t, err := unmarshalJSONTime(data, f, jsonFormats[JSONFormatDate], rfc3339JSONFormat{})
+	if err != nil {
 		return err
 	}
+	return dt.setChecked(t)
+}
 
-	dt.src = fixTimeToDate(t)
-	return nil
+// marshalJSONWith format the midnight of dt in UTC by f
+func (dt Date) marshalJSONWith(f JSONFormat) ([]byte, error) {
+	if err := dt.validate(); err != nil {
+		return nil, err
+	}
+	if dt.zero {
+		return []byte(`"` + mySQLZeroDate + `"`), nil
+	}
+	if f == jsonFormats[JSONFormatDate] {
+		dst := dt.appendFormat(append(make([]byte, 0, len(dateFormatLayout)+2), '"'))
+		return append(dst, '"'), nil
+	}
+	return f.MarshalJSONTime(dt.Time())
 }
 
-// MarshalJSON  behavior as time.Time
-func (dt *Date) MarshalJSON() ([]byte, error) {
-	return dt.src.MarshalJSON()
+// GobEncode encode as MarshalBinary
+func (dt Date) GobEncode() ([]byte, error) {
+	return dt.MarshalBinary()
+}
+
+// GobDecode decode as UnmarshalBinary
+func (dt *Date) GobDecode(data []byte) error {
+	return dt.UnmarshalBinary(data)
+}
+
+// MarshalXML format as "YYYY-MM-DD" element
+func (dt Date) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	s, err := dt.naturalText()
+	if err != nil {
+		return err
+	}
+	return e.EncodeElement(s, start)
+}
+
+// UnmarshalXML parse element as UnmarshalYAML
+func (dt *Date) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	s, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	return dt.parseNaturalText(s)
+}
+
+// MarshalXMLAttr format as "YYYY-MM-DD" attribute
+func (dt Date) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	s, err := dt.naturalText()
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: s}, nil
+}
+
+// UnmarshalXMLAttr parse attribute as UnmarshalYAML
+func (dt *Date) UnmarshalXMLAttr(attr xml.Attr) error {
+	return dt.parseNaturalText(attr.Value)
+}
+
+// MarshalYAML format as "YYYY-MM-DD" string
+func (dt Date) MarshalYAML() (interface{}, error) {
+	return dt.naturalText()
+}
+
+// UnmarshalYAML parse "YYYY-MM-DD", "0000-00-00" or date and time string accepted by Scan
+func (dt *Date) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	s, err := unmarshalYAMLText(unmarshal)
+	if err != nil {
+		return err
+	}
+	return dt.parseNaturalText(s)
+}
+
+// MarshalTOML format as TOML local date, e.g. 2018-08-20
+// MySQL zero date is formatted as TOML string, since it is not a valid TOML date.
+func (dt Date) MarshalTOML() ([]byte, error) {
+	s, err := dt.naturalText()
+	if err != nil {
+		return nil, err
+	}
+	if dt.zero {
+		return quoteTOML(s), nil
+	}
+	return []byte(s), nil
+}
+
+// UnmarshalTOML take the date of TOML date and time in its time zone, or parse string as UnmarshalYAML
+func (dt *Date) UnmarshalTOML(v interface{}) error {
+	switch v := v.(type) {
+	case time.Time:
+		return dt.setChecked(v)
+	case string:
+		return dt.parseNaturalText(v)
+	default:
+		return &InvalidValueTypeError{Value: v, Target: "Date"}
+	}
+}
+
+// naturalText format as "YYYY-MM-DD" for XML, YAML and TOML
+func (dt Date) naturalText() (string, error) {
+	if err := dt.validate(); err != nil {
+		return "", err
+	}
+	return dt.String(), nil
+}
+
+func (dt *Date) parseNaturalText(s string) error {
+	if isMySQLZeroLiteral(s) {
+		*dt = MySQLZeroDate()
+		return nil
+	}
+	t, err := parseTimeText(s, dateLayouts)
+	if err != nil {
+		return err
+	}
+	return dt.setChecked(t)
 }
 
 const dateFormatLayout = "2006-01-02"
 
+// dateTextSuffix suffix of RFC 3339 text of Date
+const dateTextSuffix = "T00:00:00Z"
+
 var _ driver.Valuer = Date{}
 var _ sql.Scanner = &Date{}
 var _ encoding.TextUnmarshaler = &Date{}
-var _ encoding.TextMarshaler = DateTime{}
-var _ encoding.BinaryMarshaler = DateTime{}
-var _ encoding.BinaryUnmarshaler = &DateTime{}
-var _ json.Marshaler = DateTime{}
-var _ json.Unmarshaler = &DateTime{}
+var _ encoding.TextMarshaler = Date{}
+var _ encoding.BinaryMarshaler = Date{}
+var _ encoding.BinaryUnmarshaler = &Date{}
+var _ json.Marshaler = Date{}
+var _ json.Unmarshaler = &Date{}
 
 // Scan for sql.Scanner
 func (dt *Date) Scan(value interface{}) error {
-	src, ok := value.(time.Time)
-
-	var dst Date
-	if ok {
-
-		dst = NewDateFromTime(src)
-
-	} else {
-		src, ok := value.([]byte)
-		if !ok {
-			return ErrInvalidValueType
-		}
-		t, err := time.Parse(dateFormatLayout, string(src))
+	if isMySQLZeroValue(value) {
+		sentinel, err := scanMySQLZero()
 		if err != nil {
 			return err
 		}
-		dst = NewDateFromTime(t)
+		*dt = Date{zero: sentinel}
+		return nil
+	}
+	t, err := scanTime(value, "Date", dateLayouts)
+	if err != nil {
+		return err
 	}
-	dt.src = dst.src
+	*dt = NewDateFromTime(t)
 	return nil
 }
 
 // Value for driver.Valuer
+// It is "YYYY-MM-DD" text, since the driver would convert time.Time into loc parameter of DSN and may shift the date.
 func (dt Date) Value() (driver.Value, error) {
-	return dt.src, nil
+	if err := dt.validate(); err != nil {
+		return nil, err
+	}
+	return dt.String(), nil
+}
+
+// GormDataType column type for gorm AutoMigrate
+func (Date) GormDataType(gorm.Dialect) string {
+	return "date"
+}
+
+func (dt *Date) setChecked(t time.Time) error {
+	dst := NewDateFromTime(t)
+	if err := dst.validate(); err != nil {
+		return err
+	}
+	*dt = dst
+	return nil
 }
 
-func fixTimeToDate(t time.Time) time.Time {
-	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+// appendFormat append dt formatted as YYYY-MM-DD
+// dt must be in MySQL DATE range, so that year has 4 digits.
+func (dt Date) appendFormat(b []byte) []byte {
+	year, month, day := dt.Date()
+	return append(b,
+		byte('0'+year/1000), byte('0'+year/100%10), byte('0'+year/10%10), byte('0'+year%10), '-',
+		byte('0'+month/10), byte('0'+month%10), '-',
+		byte('0'+day/10), byte('0'+day%10),
+	)
+}
+
+// unixEpochDays days from 0001-01-01 to 1970-01-01
+const unixEpochDays = 719162
+
+var (
+	minDateDays = int32(daysFromCivil(1000, time.January, 1))
+	maxDateDays = int32(daysFromCivil(9999, time.December, 31))
+)
+
+// daysFromCivil days since 0001-01-01 in proleptic Gregorian calendar
+// month and day must be normalized.
+// http://howardhinnant.github.io/date_algorithms.html#days_from_civil
+func daysFromCivil(year int, month time.Month, day int) int64 {
+	y := int64(year)
+	if month <= time.February {
+		y--
+	}
+	era := floorDiv(y, 400)
+	yoe := y - era*400
+	m := int64(month)
+	if m > 2 {
+		m -= 3
+	} else {
+		m += 9
+	}
+	doy := (153*m+2)/5 + int64(day) - 1
+	doe := yoe*365 + yoe/4 - yoe/100 + doy
+	return era*146097 + doe - 306
+}
+
+// civilFromDays proleptic Gregorian date of days since 0001-01-01
+// http://howardhinnant.github.io/date_algorithms.html#civil_from_days
+func civilFromDays(days int64) (year int, month time.Month, day int) {
+	z := days + 306
+	era := floorDiv(z, 146097)
+	doe := z - era*146097
+	yoe := (doe - doe/1460 + doe/36524 - doe/146096) / 365
+	doy := doe - (365*yoe + yoe/4 - yoe/100)
+	mp := (5*doy + 2) / 153
+	day = int(doy - (153*mp+2)/5 + 1)
+	if mp < 10 {
+		month = time.Month(mp + 3)
+	} else {
+		month = time.Month(mp - 9)
+	}
+	y := yoe + era*400
+	if month <= time.February {
+		y++
+	}
+	return int(y), month, day
+}
+
+func floorDiv(a, b int64) int64 {
+	if a < 0 {
+		return (a - b + 1) / b
+	}
+	return a / b
 }
@@ -0,0 +1,119 @@
+package mysqltype
+
+import (
+	"time"
+)
+
+// AddMonthsClamped add months to dt, clamping the day to the end of the month
+// Unlike AddDate, 2024-01-31 + 1 month is 2024-02-29 instead of 2024-03-02.
+func (dt Date) AddMonthsClamped(months int) Date {
+	year, month, day := dt.Date()
+	first := NewDate(year, month+time.Month(months), 1)
+	if last := first.DaysInMonth(); day > last {
+		day = last
+	}
+	return NewDate(first.Year(), first.Month(), day)
+}
+
+// AddYearsClamped add years to dt, clamping February 29 to February 28 in common years
+func (dt Date) AddYearsClamped(years int) Date {
+	return dt.AddMonthsClamped(years * 12)
+}
+
+// DaysInMonth number of days in the month of dt
+func (dt Date) DaysInMonth() int {
+	year, month, _ := dt.Date()
+	return daysIn(year, month)
+}
+
+// StartOfMonth first day of the month of dt
+func (dt Date) StartOfMonth() Date {
+	year, month, _ := dt.Date()
+	return NewDate(year, month, 1)
+}
+
+// EndOfMonth last day of the month of dt
+func (dt Date) EndOfMonth() Date {
+	year, month, _ := dt.Date()
+	return NewDate(year, month+1, 0)
+}
+
+// Quarter calendar quarter of dt from 1 to 4
+func (dt Date) Quarter() int {
+	return (int(dt.Month())-1)/3 + 1
+}
+
+// StartOfQuarter first day of the calendar quarter of dt
+func (dt Date) StartOfQuarter() Date {
+	return NewDate(dt.Year(), time.Month((dt.Quarter()-1)*3+1), 1)
+}
+
+// EndOfQuarter last day of the calendar quarter of dt
+func (dt Date) EndOfQuarter() Date {
+	return NewDate(dt.Year(), time.Month(dt.Quarter()*3+1), 0)
+}
+
+// StartOfISOWeek Monday of the ISO 8601 week of dt
+func (dt Date) StartOfISOWeek() Date {
+	days := (int(dt.Weekday()) + 6) % 7
+	return dt.AddDate(0, 0, -days)
+}
+
+// EndOfISOWeek Sunday of the ISO 8601 week of dt
+func (dt Date) EndOfISOWeek() Date {
+	return dt.StartOfISOWeek().AddDate(0, 0, 6)
+}
+
+// StartOfYear January 1 of the year of dt
+func (dt Date) StartOfYear() Date {
+	return NewDate(dt.Year(), time.January, 1)
+}
+
+// EndOfYear December 31 of the year of dt
+func (dt Date) EndOfYear() Date {
+	return NewDate(dt.Year(), time.December, 31)
+}
+
+// FiscalYear fiscal year of dt where fiscal years start on the first day of startMonth
+// Fiscal years are named by the calendar year they start in,
+// e.g. 2019-03-31 is in fiscal year 2018 if startMonth is April.
+func (dt Date) FiscalYear(startMonth time.Month) int {
+	if dt.Month() < startMonth {
+		return dt.Year() - 1
+	}
+	return dt.Year()
+}
+
+// FiscalQuarter quarter of dt from 1 to 4 in the fiscal year starting on startMonth
+func (dt Date) FiscalQuarter(startMonth time.Month) int {
+	months := (int(dt.Month()) - int(startMonth) + 12) % 12
+	return months/3 + 1
+}
+
+// StartOfFiscalYear first day of the fiscal year of dt starting on startMonth
+func (dt Date) StartOfFiscalYear(startMonth time.Month) Date {
+	return NewDate(dt.FiscalYear(startMonth), startMonth, 1)
+}
+
+// EndOfFiscalYear last day of the fiscal year of dt starting on startMonth
+func (dt Date) EndOfFiscalYear(startMonth time.Month) Date {
+	return NewDate(dt.FiscalYear(startMonth)+1, startMonth, 0)
+}
+
+// AddDays add days to dt
+func (dt Date) AddDays(days int) Date {
+	return dt.AddDate(0, 0, days)
+}
+
+// DaysSince number of days from u to dt, negative if dt is before u
+// Unlike Sub, it does not overflow for any dates.
+func (dt Date) DaysSince(u Date) int {
+	return int(int64(dt.days) - int64(u.days))
+}
+
+// DaysBetween number of days from a to b, negative if b is before a
+func DaysBetween(a, b Date) int {
+	return b.DaysSince(a)
+}
+
+const secondsPerDay = 24 * 60 * 60
@@ -0,0 +1,157 @@
+package mysqltype
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateAddMonthsClamped(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		src      Date
+		months   int
+		expected Date
+	}{
+		{NewDate(2024, 1, 31), 1, NewDate(2024, 2, 29)},
+		{NewDate(2023, 1, 31), 1, NewDate(2023, 2, 28)},
+		{NewDate(2024, 1, 31), 2, NewDate(2024, 3, 31)},
+		{NewDate(2024, 1, 31), 3, NewDate(2024, 4, 30)},
+		{NewDate(2024, 3, 31), -1, NewDate(2024, 2, 29)},
+		{NewDate(2024, 2, 29), 12, NewDate(2025, 2, 28)},
+		{NewDate(2024, 2, 29), 48, NewDate(2028, 2, 29)},
+		{NewDate(2100, 1, 31), 1, NewDate(2100, 2, 28)},
+		{NewDate(2000, 1, 31), 1, NewDate(2000, 2, 29)},
+		{NewDate(2018, 12, 31), 1, NewDate(2019, 1, 31)},
+		{NewDate(2019, 1, 15), -13, NewDate(2017, 12, 15)},
+		{NewDate(2018, 8, 31), 0, NewDate(2018, 8, 31)},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, tt.src.AddMonthsClamped(tt.months), "%v + %d months", tt.src, tt.months)
+	}
+
+	it := NewDateRange(NewDate(2023, 1, 1), NewDate(2025, 12, 31)).Days()
+	for it.Next() {
+		src := it.Date()
+		for months := -25; months <= 25; months++ {
+			actual := src.AddMonthsClamped(months)
+			assert.Equal(t, (src.Year()*12+int(src.Month())-1)+months, actual.Year()*12+int(actual.Month())-1)
+			expectedDay := src.Day()
+			if n := actual.DaysInMonth(); expectedDay > n {
+				expectedDay = n
+			}
+			if !assert.Equal(t, expectedDay, actual.Day(), "%v + %d months", src, months) {
+				return
+			}
+		}
+	}
+}
+
+func TestDateAddYearsClamped(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, NewDate(2025, 2, 28), NewDate(2024, 2, 29).AddYearsClamped(1))
+	assert.Equal(t, NewDate(2028, 2, 29), NewDate(2024, 2, 29).AddYearsClamped(4))
+	assert.Equal(t, NewDate(2100, 2, 28), NewDate(2096, 2, 29).AddYearsClamped(4))
+	assert.Equal(t, NewDate(2023, 2, 28), NewDate(2024, 2, 29).AddYearsClamped(-1))
+	assert.Equal(t, NewDate(2019, 8, 31), NewDate(2018, 8, 31).AddYearsClamped(1))
+}
+
+func TestDateDaysInMonth(t *testing.T) {
+	t.Parallel()
+	expected := []int{31, 28, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+	for i, days := range expected {
+		assert.Equal(t, days, NewDate(2023, time.Month(i+1), 15).DaysInMonth())
+	}
+	assert.Equal(t, 29, NewDate(2024, 2, 1).DaysInMonth())
+	assert.Equal(t, 29, NewDate(2000, 2, 1).DaysInMonth())
+	assert.Equal(t, 28, NewDate(1900, 2, 1).DaysInMonth())
+}
+
+func TestDateStartAndEndOfMonth(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, NewDate(2024, 2, 1), NewDate(2024, 2, 29).StartOfMonth())
+	assert.Equal(t, NewDate(2024, 2, 29), NewDate(2024, 2, 1).EndOfMonth())
+	assert.Equal(t, NewDate(2023, 2, 28), NewDate(2023, 2, 10).EndOfMonth())
+	assert.Equal(t, NewDate(2018, 12, 31), NewDate(2018, 12, 31).EndOfMonth())
+	assert.Equal(t, MinDate(), MinDate().StartOfMonth())
+	assert.Equal(t, MaxDate(), MaxDate().EndOfMonth())
+}
+
+func TestDateQuarter(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		src     Date
+		quarter int
+		start   Date
+		end     Date
+	}{
+		{NewDate(2024, 1, 1), 1, NewDate(2024, 1, 1), NewDate(2024, 3, 31)},
+		{NewDate(2024, 3, 31), 1, NewDate(2024, 1, 1), NewDate(2024, 3, 31)},
+		{NewDate(2024, 4, 1), 2, NewDate(2024, 4, 1), NewDate(2024, 6, 30)},
+		{NewDate(2024, 8, 15), 3, NewDate(2024, 7, 1), NewDate(2024, 9, 30)},
+		{NewDate(2024, 12, 31), 4, NewDate(2024, 10, 1), NewDate(2024, 12, 31)},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.quarter, tt.src.Quarter())
+		assert.Equal(t, tt.start, tt.src.StartOfQuarter())
+		assert.Equal(t, tt.end, tt.src.EndOfQuarter())
+	}
+}
+
+func TestDateStartOfISOWeek(t *testing.T) {
+	t.Parallel()
+	// 2018-08-06 is Monday
+	for day := 6; day <= 12; day++ {
+		assert.Equal(t, NewDate(2018, 8, 6), NewDate(2018, 8, day).StartOfISOWeek())
+		assert.Equal(t, NewDate(2018, 8, 12), NewDate(2018, 8, day).EndOfISOWeek())
+	}
+	// 2021-01-01 is Friday in ISO week 2020-W53
+	start := NewDate(2021, 1, 1).StartOfISOWeek()
+	assert.Equal(t, NewDate(2020, 12, 28), start)
+	year, week := start.ISOWeek()
+	assert.Equal(t, 2020, year)
+	assert.Equal(t, 53, week)
+}
+
+func TestDateFiscalYear(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		src        Date
+		startMonth time.Month
+		year       int
+		quarter    int
+		start      Date
+		end        Date
+	}{
+		{NewDate(2019, 3, 31), time.April, 2018, 4, NewDate(2018, 4, 1), NewDate(2019, 3, 31)},
+		{NewDate(2019, 4, 1), time.April, 2019, 1, NewDate(2019, 4, 1), NewDate(2020, 3, 31)},
+		{NewDate(2019, 12, 31), time.April, 2019, 3, NewDate(2019, 4, 1), NewDate(2020, 3, 31)},
+		{NewDate(2019, 9, 30), time.October, 2018, 4, NewDate(2018, 10, 1), NewDate(2019, 9, 30)},
+		{NewDate(2024, 2, 29), time.March, 2023, 4, NewDate(2023, 3, 1), NewDate(2024, 2, 29)},
+		{NewDate(2019, 6, 15), time.January, 2019, 2, NewDate(2019, 1, 1), NewDate(2019, 12, 31)},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.year, tt.src.FiscalYear(tt.startMonth), tt.src.String())
+		assert.Equal(t, tt.quarter, tt.src.FiscalQuarter(tt.startMonth), tt.src.String())
+		assert.Equal(t, tt.start, tt.src.StartOfFiscalYear(tt.startMonth), tt.src.String())
+		assert.Equal(t, tt.end, tt.src.EndOfFiscalYear(tt.startMonth), tt.src.String())
+	}
+}
+
+func TestDateStartAndEndOfYear(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, NewDate(2024, 1, 1), NewDate(2024, 2, 29).StartOfYear())
+	assert.Equal(t, NewDate(2024, 12, 31), NewDate(2024, 2, 29).EndOfYear())
+}
+
+func TestDateDaysSince(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, 0, NewDate(2018, 8, 20).DaysSince(NewDate(2018, 8, 20)))
+	assert.Equal(t, 366, NewDate(2025, 1, 1).DaysSince(NewDate(2024, 1, 1)))
+	assert.Equal(t, -365, NewDate(2023, 1, 1).DaysSince(NewDate(2024, 1, 1)))
+	assert.Equal(t, 3287181, MaxDate().DaysSince(MinDate()))
+	assert.Equal(t, -3287181, DaysBetween(MaxDate(), MinDate()))
+	assert.Equal(t, MaxDate(), MinDate().AddDays(3287181))
+	assert.Equal(t, NewDate(2024, 3, 1), NewDate(2024, 2, 28).AddDays(2))
+}
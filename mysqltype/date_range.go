@@ -0,0 +1,323 @@
+package mysqltype
+
+import (
+	"encoding/json"
+
+	"github.com/jinzhu/gorm"
+)
+
+// Bounds inclusive or exclusive of range ends
+type Bounds int
+
+const (
+	// BoundsClosed [start, end]
+	BoundsClosed Bounds = iota
+	// BoundsClosedOpen [start, end)
+	BoundsClosedOpen
+	// BoundsOpenClosed (start, end]
+	BoundsOpenClosed
+	// BoundsOpen (start, end)
+	BoundsOpen
+)
+
+var boundsNames = [...]string{"[]", "[)", "(]", "()"}
+
+// String format as "[]", "[)", "(]" or "()"
+func (b Bounds) String() string {
+	if b < BoundsClosed || BoundsOpen < b {
+		return ""
+	}
+	return boundsNames[b]
+}
+
+// ParseBounds parse "[]", "[)", "(]" or "()"
+func ParseBounds(s string) (Bounds, error) {
+	for i, name := range boundsNames {
+		if s == name {
+			return Bounds(i), nil
+		}
+	}
+	return BoundsClosed, ErrInvalidFormat
+}
+
+func (b Bounds) startExclusive() bool {
+	return b == BoundsOpenClosed || b == BoundsOpen
+}
+
+func (b Bounds) endExclusive() bool {
+	return b == BoundsClosedOpen || b == BoundsOpen
+}
+
+// DateRange range of Date, Start and End are both inclusive
+// Invalid Start or End means the range is unbounded on that side, so the zero value contains every date.
+// The range is empty if Start is after End.
+//
+// Embed it to store the range in two DATE columns, e.g. valid_start and valid_end:
+//
+//	ValidPeriod DateRange `gorm:"embedded;embedded_prefix:valid_"`
+//
+// Use DateRangeColumns for columns which are not named <prefix>start and <prefix>end.
+type DateRange struct {
+	Start NullDate `json:"start"`
+	End   NullDate `json:"end"`
+}
+
+// NewDateRange Create new DateRange of [start, end]
+func NewDateRange(start, end Date) DateRange {
+	return DateRange{Start: NewNullDate(start), End: NewNullDate(end)}
+}
+
+// NewDateRangeWithBounds Create new DateRange from start and end with bounds
+// Exclusive ends are converted to inclusive ones.
+func NewDateRangeWithBounds(start, end NullDate, bounds Bounds) DateRange {
+	if start.Valid && bounds.startExclusive() {
+		start = NewNullDate(start.Date.AddDate(0, 0, 1))
+	}
+	if end.Valid && bounds.endExclusive() {
+		end = NewNullDate(end.Date.AddDate(0, 0, -1))
+	}
+	return DateRange{Start: start, End: end}
+}
+
+// NewDateRangeFrom Create new DateRange of [start, +∞)
+func NewDateRangeFrom(start Date) DateRange {
+	return DateRange{Start: NewNullDate(start)}
+}
+
+// NewDateRangeUntil Create new DateRange of (-∞, end]
+func NewDateRangeUntil(end Date) DateRange {
+	return DateRange{End: NewNullDate(end)}
+}
+
+// IsEmpty reports whether r contains no date
+func (r DateRange) IsEmpty() bool {
+	return r.Start.Valid && r.End.Valid && r.Start.Date.After(r.End.Date)
+}
+
+// Equal reports whether r and u contain same dates
+func (r DateRange) Equal(u DateRange) bool {
+	if r.IsEmpty() || u.IsEmpty() {
+		return r.IsEmpty() == u.IsEmpty()
+	}
+	return r.Start.Equal(u.Start) && r.End.Equal(u.End)
+}
+
+// Contains reports whether d is in r
+func (r DateRange) Contains(d Date) bool {
+	return (!r.Start.Valid || !d.Before(r.Start.Date)) && (!r.End.Valid || !d.After(r.End.Date))
+}
+
+// ContainsRange reports whether every date in u is in r
+func (r DateRange) ContainsRange(u DateRange) bool {
+	if u.IsEmpty() {
+		return true
+	}
+	startOK := !r.Start.Valid || (u.Start.Valid && !u.Start.Date.Before(r.Start.Date))
+	endOK := !r.End.Valid || (u.End.Valid && !u.End.Date.After(r.End.Date))
+	return startOK && endOK
+}
+
+// Overlaps reports whether r and u have any date in common
+func (r DateRange) Overlaps(u DateRange) bool {
+	return !r.Intersect(u).IsEmpty()
+}
+
+// Intersect range of dates in both r and u
+func (r DateRange) Intersect(u DateRange) DateRange {
+	dst := r
+	if !dst.Start.Valid || (u.Start.Valid && u.Start.Date.After(dst.Start.Date)) {
+		dst.Start = u.Start
+	}
+	if !dst.End.Valid || (u.End.Valid && u.End.Date.Before(dst.End.Date)) {
+		dst.End = u.End
+	}
+	return dst
+}
+
+// Union range of dates in r or u
+// It reports false if r and u neither overlap nor adjoin, since the result is not a single range.
+func (r DateRange) Union(u DateRange) (DateRange, bool) {
+	if r.IsEmpty() {
+		return u, true
+	}
+	if u.IsEmpty() {
+		return r, true
+	}
+	if hasGap(r, u) || hasGap(u, r) {
+		return DateRange{}, false
+	}
+	dst := r
+	if dst.Start.Valid && (!u.Start.Valid || u.Start.Date.Before(dst.Start.Date)) {
+		dst.Start = u.Start
+	}
+	if dst.End.Valid && (!u.End.Valid || u.End.Date.After(dst.End.Date)) {
+		dst.End = u.End
+	}
+	return dst, true
+}
+
+// hasGap reports whether some date exists between end of r and start of u
+func hasGap(r, u DateRange) bool {
+	return r.End.Valid && u.Start.Valid && r.End.Date.AddDate(0, 0, 1).Before(u.Start.Date)
+}
+
+// Days iterator of each date in r
+// Unbounded ends are limited to MinDate and MaxDate.
+func (r DateRange) Days() *DayIterator {
+	it := &DayIterator{next: MinDate(), last: MaxDate()}
+	if r.Start.Valid {
+		it.next = r.Start.Date
+	}
+	if r.End.Valid {
+		it.last = r.End.Date
+	}
+	return it
+}
+
+// DayIterator iterates dates in DateRange
+//
+//	it := r.Days()
+//	for it.Next() {
+//	    d := it.Date()
+//	}
+type DayIterator struct {
+	current Date
+	next    Date
+	last    Date
+}
+
+// Next advances to the next date, and reports false if no date remains
+func (it *DayIterator) Next() bool {
+	if it.next.After(it.last) {
+		return false
+	}
+	it.current = it.next
+	it.next = it.next.AddDate(0, 0, 1)
+	return true
+}
+
+// Date current date
+func (it *DayIterator) Date() Date {
+	return it.current
+}
+
+type dateRangeJSON struct {
+	Start  NullDate `json:"start"`
+	End    NullDate `json:"end"`
+	Bounds string   `json:"bounds,omitempty"`
+}
+
+// UnmarshalJSON parse JSON object {"start": ..., "end": ..., "bounds": "[)"}
+// null or missing start and end mean unbounded, and missing bounds means "[]".
+func (r *DateRange) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var v dateRangeJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	bounds := BoundsClosed
+	if v.Bounds != "" {
+		var err error
+		if bounds, err = ParseBounds(v.Bounds); err != nil {
+			return err
+		}
+	}
+	*r = NewDateRangeWithBounds(v.Start, v.End, bounds)
+	return nil
+}
+
+var _ json.Unmarshaler = &DateRange{}
+
+// DateRangeColumns names of two DATE columns which store DateRange
+// Declare the columns as NullDate fields of any names, and map DateRange to them:
+//
+//	type Contract struct {
+//		ID         int
+//		ValidFrom  mysqltype.NullDate
+//		ValidUntil mysqltype.NullDate
+//	}
+//
+//	var validity = mysqltype.DateRangeColumns{Start: "valid_from", End: "valid_until"}
+//
+//	db.Model(&contract).Updates(validity.Values(r))
+//	db.Scopes(validity.ContainsScope(today)).Find(&contracts)
+//
+// The range of a row is DateRange{Start: contract.ValidFrom, End: contract.ValidUntil}.
+type DateRangeColumns struct {
+	Start string
+	End   string
+}
+
+// Values column values of r for gorm Updates
+func (c DateRangeColumns) Values(r DateRange) map[string]interface{} {
+	return map[string]interface{}{c.Start: r.Start, c.End: r.End}
+}
+
+// ContainsScope gorm scope to find rows whose range contains d
+// NULL columns mean unbounded.
+func (c DateRangeColumns) ContainsScope(d Date) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		query, args := c.containsCondition(db.NewScope(nil).Quote, d)
+		return db.Where(query, args...)
+	}
+}
+
+// OverlapsScope gorm scope to find rows whose range overlaps r
+// NULL columns mean unbounded, and each column is compared by a single range condition so that its index can be used.
+func (c DateRangeColumns) OverlapsScope(r DateRange) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		query, args := c.overlapsCondition(db.NewScope(nil).Quote, r)
+		return db.Where(query, args...)
+	}
+}
+
+// WithinScope gorm scope to find rows whose range is in r
+// NULL columns mean unbounded.
+func (c DateRangeColumns) WithinScope(r DateRange) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		query, args := c.withinCondition(db.NewScope(nil).Quote, r)
+		return db.Where(query, args...)
+	}
+}
+
+func (c DateRangeColumns) containsCondition(quote func(string) string, d Date) (string, []interface{}) {
+	query := "(" + quote(c.Start) + " IS NULL OR " + quote(c.Start) + " <= ?) AND (" +
+		quote(c.End) + " IS NULL OR " + quote(c.End) + " >= ?)"
+	return query, []interface{}{d, d}
+}
+
+func (c DateRangeColumns) overlapsCondition(quote func(string) string, r DateRange) (string, []interface{}) {
+	if r.IsEmpty() {
+		return "1 = 0", nil
+	}
+	var conditions []string
+	var args []interface{}
+	if r.End.Valid {
+		conditions = append(conditions, "("+quote(c.Start)+" IS NULL OR "+quote(c.Start)+" <= ?)")
+		args = append(args, r.End.Date)
+	}
+	if r.Start.Valid {
+		conditions = append(conditions, "("+quote(c.End)+" IS NULL OR "+quote(c.End)+" >= ?)")
+		args = append(args, r.Start.Date)
+	}
+	return joinConditions(conditions), args
+}
+
+func (c DateRangeColumns) withinCondition(quote func(string) string, r DateRange) (string, []interface{}) {
+	if r.IsEmpty() {
+		return "1 = 0", nil
+	}
+	var conditions []string
+	var args []interface{}
+	if r.Start.Valid {
+		conditions = append(conditions, quote(c.Start)+" >= ?")
+		args = append(args, r.Start.Date)
+	}
+	if r.End.Valid {
+		conditions = append(conditions, quote(c.End)+" <= ?")
+		args = append(args, r.End.Date)
+	}
+	return joinConditions(conditions), args
+}
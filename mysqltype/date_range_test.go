@@ -0,0 +1,247 @@
+package mysqltype
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+)
+
+type DateRangeFieldTestStruct struct {
+	ID          int
+	ValidPeriod DateRange `gorm:"embedded;embedded_prefix:valid_"`
+}
+
+func TestDateRangeField(t *testing.T) {
+	t.Parallel()
+	target := &DateRangeFieldTestStruct{
+		ValidPeriod: NewDateRangeFrom(NewDate(2018, 8, 20)),
+	}
+	assert.NoError(t, DB.AutoMigrate(target).Error)
+	assert.NoError(t, DB.Create(target).Error)
+
+	dst := &DateRangeFieldTestStruct{ID: target.ID}
+	assert.NoError(t, DB.First(dst).Error)
+	assert.True(t, target.ValidPeriod.Equal(dst.ValidPeriod))
+
+	var count int
+	assert.NoError(t, DB.Table("date_range_field_test_structs").
+		Where("id = ? and valid_start = ? and valid_end is null", target.ID, NewDate(2018, 8, 20)).
+		Count(&count).Error)
+	assert.Equal(t, 1, count)
+
+	var dataType string
+	assert.NoError(t, DB.Raw("select data_type from information_schema.columns where table_schema = database() and table_name = ? and column_name = ?",
+		"date_range_field_test_structs", "valid_end").Row().Scan(&dataType))
+	assert.Equal(t, "date", dataType)
+}
+
+type DateRangeColumnsTestStruct struct {
+	ID         int
+	ValidFrom  NullDate
+	ValidUntil NullDate
+}
+
+var dateRangeTestColumns = DateRangeColumns{Start: "valid_from", End: "valid_until"}
+
+func TestDateRangeColumns(t *testing.T) {
+	t.Parallel()
+	targets := []*DateRangeColumnsTestStruct{{}, {}, {}}
+	ranges := []DateRange{
+		NewDateRange(NewDate(2018, 8, 1), NewDate(2018, 8, 10)),
+		NewDateRange(NewDate(2018, 8, 11), NewDate(2018, 8, 20)),
+		NewDateRangeFrom(NewDate(2018, 8, 21)),
+	}
+	assert.NoError(t, DB.AutoMigrate(targets[0]).Error)
+	for i, target := range targets {
+		assert.NoError(t, DB.Create(target).Error)
+		assert.NoError(t, DB.Model(target).Updates(dateRangeTestColumns.Values(ranges[i])).Error)
+	}
+	ids := []int{targets[0].ID, targets[1].ID, targets[2].ID}
+
+	dst := &DateRangeColumnsTestStruct{ID: targets[1].ID}
+	assert.NoError(t, DB.First(dst).Error)
+	assert.True(t, ranges[1].Equal(DateRange{Start: dst.ValidFrom, End: dst.ValidUntil}))
+
+	tests := []struct {
+		scope    func(*gorm.DB) *gorm.DB
+		expected []int
+	}{
+		{dateRangeTestColumns.ContainsScope(NewDate(2018, 8, 10)), ids[:1]},
+		{dateRangeTestColumns.ContainsScope(NewDate(2100, 1, 1)), ids[2:]},
+		{dateRangeTestColumns.OverlapsScope(NewDateRange(NewDate(2018, 8, 10), NewDate(2018, 8, 11))), ids[:2]},
+		{dateRangeTestColumns.OverlapsScope(NewDateRangeFrom(NewDate(2018, 8, 20))), ids[1:]},
+		{dateRangeTestColumns.WithinScope(NewDateRangeUntil(NewDate(2018, 8, 20))), ids[:2]},
+	}
+	for _, tt := range tests {
+		var actual []int
+		assert.NoError(t, DB.Model(&DateRangeColumnsTestStruct{}).Scopes(tt.scope).Where("id in (?)", ids).Order("id").Pluck("id", &actual).Error)
+		assert.Equal(t, tt.expected, actual)
+	}
+}
+
+func TestDateRangeColumnsCondition(t *testing.T) {
+	t.Parallel()
+	quote := func(s string) string { return "`" + s + "`" }
+	start := NewDate(2018, 8, 1)
+	end := NewDate(2018, 8, 10)
+
+	assert.Equal(t, map[string]interface{}{"valid_from": NewNullDate(start), "valid_until": NullDate{}},
+		dateRangeTestColumns.Values(NewDateRangeFrom(start)))
+
+	query, args := dateRangeTestColumns.containsCondition(quote, start)
+	assert.Equal(t, "(`valid_from` IS NULL OR `valid_from` <= ?) AND (`valid_until` IS NULL OR `valid_until` >= ?)", query)
+	assert.Equal(t, []interface{}{start, start}, args)
+
+	query, args = dateRangeTestColumns.overlapsCondition(quote, NewDateRange(start, end))
+	assert.Equal(t, "(`valid_from` IS NULL OR `valid_from` <= ?) AND (`valid_until` IS NULL OR `valid_until` >= ?)", query)
+	assert.Equal(t, []interface{}{end, start}, args)
+
+	query, args = dateRangeTestColumns.overlapsCondition(quote, DateRange{})
+	assert.Equal(t, "1 = 1", query)
+	assert.Empty(t, args)
+
+	query, args = dateRangeTestColumns.overlapsCondition(quote, NewDateRange(end, start))
+	assert.Equal(t, "1 = 0", query)
+	assert.Empty(t, args)
+
+	query, args = dateRangeTestColumns.withinCondition(quote, NewDateRangeUntil(end))
+	assert.Equal(t, "`valid_until` <= ?", query)
+	assert.Equal(t, []interface{}{end}, args)
+}
+
+func TestNewDateRangeWithBounds(t *testing.T) {
+	t.Parallel()
+	start := NewNullDate(NewDate(2018, 8, 1))
+	end := NewNullDate(NewDate(2018, 9, 1))
+
+	tests := []struct {
+		bounds   Bounds
+		expected DateRange
+	}{
+		{BoundsClosed, NewDateRange(NewDate(2018, 8, 1), NewDate(2018, 9, 1))},
+		{BoundsClosedOpen, NewDateRange(NewDate(2018, 8, 1), NewDate(2018, 8, 31))},
+		{BoundsOpenClosed, NewDateRange(NewDate(2018, 8, 2), NewDate(2018, 9, 1))},
+		{BoundsOpen, NewDateRange(NewDate(2018, 8, 2), NewDate(2018, 8, 31))},
+	}
+	for _, tt := range tests {
+		assert.True(t, tt.expected.Equal(NewDateRangeWithBounds(start, end, tt.bounds)), tt.bounds.String())
+	}
+
+	assert.True(t, NewDateRangeFrom(NewDate(2018, 8, 2)).Equal(NewDateRangeWithBounds(start, NullDate{}, BoundsOpen)))
+	assert.True(t, NewDateRangeWithBounds(start, start, BoundsClosedOpen).IsEmpty())
+}
+
+func TestDateRangeContains(t *testing.T) {
+	t.Parallel()
+	r := NewDateRange(NewDate(2018, 8, 1), NewDate(2018, 8, 31))
+	assert.True(t, r.Contains(NewDate(2018, 8, 1)))
+	assert.True(t, r.Contains(NewDate(2018, 8, 31)))
+	assert.False(t, r.Contains(NewDate(2018, 7, 31)))
+	assert.False(t, r.Contains(NewDate(2018, 9, 1)))
+
+	assert.True(t, DateRange{}.Contains(MinDate()))
+	assert.True(t, NewDateRangeFrom(NewDate(2018, 8, 1)).Contains(MaxDate()))
+	assert.False(t, NewDateRangeUntil(NewDate(2018, 8, 1)).Contains(NewDate(2018, 8, 2)))
+}
+
+func TestDateRangeContainsRange(t *testing.T) {
+	t.Parallel()
+	r := NewDateRange(NewDate(2018, 8, 1), NewDate(2018, 8, 31))
+	assert.True(t, r.ContainsRange(r))
+	assert.True(t, r.ContainsRange(NewDateRange(NewDate(2018, 8, 10), NewDate(2018, 8, 20))))
+	assert.False(t, r.ContainsRange(NewDateRange(NewDate(2018, 8, 10), NewDate(2018, 9, 20))))
+	assert.False(t, r.ContainsRange(NewDateRangeFrom(NewDate(2018, 8, 10))))
+	assert.True(t, NewDateRangeFrom(NewDate(2018, 8, 1)).ContainsRange(NewDateRangeFrom(NewDate(2018, 8, 10))))
+	assert.True(t, r.ContainsRange(NewDateRange(NewDate(2018, 9, 10), NewDate(2018, 9, 1))))
+}
+
+func TestDateRangeOverlapsAndIntersect(t *testing.T) {
+	t.Parallel()
+	r := NewDateRange(NewDate(2018, 8, 1), NewDate(2018, 8, 31))
+
+	tests := []struct {
+		u        DateRange
+		expected DateRange
+		overlaps bool
+	}{
+		{NewDateRange(NewDate(2018, 8, 31), NewDate(2018, 9, 30)), NewDateRange(NewDate(2018, 8, 31), NewDate(2018, 8, 31)), true},
+		{NewDateRange(NewDate(2018, 9, 1), NewDate(2018, 9, 30)), NewDateRange(NewDate(2018, 9, 1), NewDate(2018, 8, 31)), false},
+		{NewDateRangeUntil(NewDate(2018, 8, 10)), NewDateRange(NewDate(2018, 8, 1), NewDate(2018, 8, 10)), true},
+		{DateRange{}, r, true},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.overlaps, r.Overlaps(tt.u))
+		assert.Equal(t, tt.overlaps, tt.u.Overlaps(r))
+		assert.True(t, tt.expected.Equal(r.Intersect(tt.u)))
+		assert.True(t, tt.expected.Equal(tt.u.Intersect(r)))
+	}
+}
+
+func TestDateRangeUnion(t *testing.T) {
+	t.Parallel()
+	r := NewDateRange(NewDate(2018, 8, 1), NewDate(2018, 8, 31))
+
+	actual, ok := r.Union(NewDateRange(NewDate(2018, 9, 1), NewDate(2018, 9, 30)))
+	assert.True(t, ok)
+	assert.True(t, NewDateRange(NewDate(2018, 8, 1), NewDate(2018, 9, 30)).Equal(actual))
+
+	actual, ok = r.Union(NewDateRangeUntil(NewDate(2018, 8, 10)))
+	assert.True(t, ok)
+	assert.True(t, NewDateRangeUntil(NewDate(2018, 8, 31)).Equal(actual))
+
+	_, ok = r.Union(NewDateRange(NewDate(2018, 9, 2), NewDate(2018, 9, 30)))
+	assert.False(t, ok)
+
+	actual, ok = r.Union(NewDateRange(NewDate(2018, 9, 2), NewDate(2018, 9, 1)))
+	assert.True(t, ok)
+	assert.True(t, r.Equal(actual))
+}
+
+func TestDateRangeDays(t *testing.T) {
+	t.Parallel()
+	var days []Date
+	it := NewDateRange(NewDate(2016, 2, 27), NewDate(2016, 3, 1)).Days()
+	for it.Next() {
+		days = append(days, it.Date())
+	}
+	assert.Equal(t, []Date{NewDate(2016, 2, 27), NewDate(2016, 2, 28), NewDate(2016, 2, 29), NewDate(2016, 3, 1)}, days)
+
+	assert.False(t, NewDateRange(NewDate(2016, 3, 1), NewDate(2016, 2, 1)).Days().Next())
+
+	it = NewDateRangeFrom(MaxDate()).Days()
+	assert.True(t, it.Next())
+	assert.Equal(t, MaxDate(), it.Date())
+	assert.False(t, it.Next())
+}
+
+func TestDateRangeMarshalJSON(t *testing.T) {
+	t.Parallel()
+	src := NewDateRangeFrom(NewDate(2018, 8, 1))
+	data, err := json.Marshal(src)
+	assert.NoError(t, err)
+
+	dst := DateRange{}
+	assert.NoError(t, json.Unmarshal(data, &dst))
+	assert.True(t, src.Equal(dst))
+
+	var actual map[string]interface{}
+	assert.NoError(t, json.Unmarshal(data, &actual))
+	assert.Contains(t, actual, "start")
+	assert.Nil(t, actual["end"])
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"start":"2018-08-01T00:00:00Z","end":"2018-09-01T00:00:00Z","bounds":"[)"}`), &dst))
+	assert.True(t, NewDateRange(NewDate(2018, 8, 1), NewDate(2018, 8, 31)).Equal(dst))
+	assert.Equal(t, ErrInvalidFormat, json.Unmarshal([]byte(`{"bounds":"[["}`), &dst))
+}
+
+func TestBounds(t *testing.T) {
+	t.Parallel()
+	for _, b := range []Bounds{BoundsClosed, BoundsClosedOpen, BoundsOpenClosed, BoundsOpen} {
+		actual, err := ParseBounds(b.String())
+		assert.NoError(t, err)
+		assert.Equal(t, b, actual)
+	}
+	assert.Equal(t, "", Bounds(-1).String())
+}
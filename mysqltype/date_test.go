@@ -1,9 +1,13 @@
 package mysqltype
 
 import (
+	"bytes"
+	"encoding/gob"
 	"encoding/json"
+	"encoding/xml"
 	"testing"
 	"time"
+	"unsafe"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -34,7 +38,7 @@ func TestDateField(t *testing.T) {
 		ID: target.ID,
 	}
 	assert.NoError(t, DB.First(dst).Error)
-	assertTimeEquals(t, target.TargetDate.src, dst.TargetDate.src)
+	assertTimeEquals(t, target.TargetDate.Time(), dst.TargetDate.Time())
 
 	assert.NoError(t, DB.Save(&DateFieldTestStruct{TargetDate: MaxDate()}).Error)
 	assert.NoError(t, DB.Save(&DateFieldTestStruct{TargetDate: MinDate()}).Error)
@@ -42,42 +46,178 @@ func TestDateField(t *testing.T) {
 
 func TestDateMarshalJSON(t *testing.T) {
 	now := NowDate()
-	expected, err := now.MarshalText()
-	assert.NoError(t, err)
 	actual, err := json.Marshal(now)
 	assert.NoError(t, err)
-	assert.EqualValues(t, "\""+string(expected)+"\"", string(actual))
+	assert.EqualValues(t, "\""+now.String()+"\"", string(actual))
+}
+
+func TestDateMarshalBinary(t *testing.T) {
+	t.Parallel()
+	v := NewDate(2018, 8, 20)
+	data, err := v.MarshalBinary()
+	assert.NoError(t, err)
+	expected, err := v.Time().MarshalBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, data)
+	dst := Date{}
+	assert.NoError(t, dst.UnmarshalBinary(data))
+	assert.Equal(t, v, dst)
+
+	_, err = MaxDate().AddDate(0, 0, 1).MarshalBinary()
+	assertOutOfRange(t, err)
+	data, err = MaxDate().Time().AddDate(0, 0, 1).MarshalBinary()
+	assert.NoError(t, err)
+	assertOutOfRange(t, dst.UnmarshalBinary(data))
+}
+
+func TestDateGobEncode(t *testing.T) {
+	t.Parallel()
+	for _, v := range []Date{NewDate(2018, 8, 20), MySQLZeroDate()} {
+		buf := &bytes.Buffer{}
+		assert.NoError(t, gob.NewEncoder(buf).Encode(v))
+		dst := Date{}
+		assert.NoError(t, gob.NewDecoder(buf).Decode(&dst))
+		assert.Equal(t, v, dst)
+	}
+}
+
+func TestDateMarshalXML(t *testing.T) {
+	t.Parallel()
+	type target struct {
+		XMLName xml.Name `xml:"target"`
+		Attr    Date     `xml:"attr,attr"`
+		Elem    Date     `xml:"elem"`
+	}
+	v := target{Attr: NewDate(2018, 8, 20), Elem: MySQLZeroDate()}
+	actual, err := xml.Marshal(v)
+	assert.NoError(t, err)
+	assert.Equal(t, `<target attr="2018-08-20"><elem>0000-00-00</elem></target>`, string(actual))
+	dst := target{}
+	assert.NoError(t, xml.Unmarshal(actual, &dst))
+	assert.Equal(t, v.Attr, dst.Attr)
+	assert.Equal(t, v.Elem, dst.Elem)
+
+	assert.NoError(t, xml.Unmarshal([]byte(`<target attr="2018-08-20T00:00:00Z"><elem>2018-08-21</elem></target>`), &dst))
+	assert.Equal(t, NewDate(2018, 8, 20), dst.Attr)
+	assert.Equal(t, NewDate(2018, 8, 21), dst.Elem)
+	assertOutOfRange(t, xml.Unmarshal([]byte(`<target attr="0999-12-31"></target>`), &dst))
+	_, err = xml.Marshal(target{})
+	assertOutOfRange(t, err)
+}
+
+func TestDateMarshalYAML(t *testing.T) {
+	t.Parallel()
+	actual, err := NewDate(2018, 8, 20).MarshalYAML()
+	assert.NoError(t, err)
+	assert.Equal(t, "2018-08-20", actual)
+
+	dst := Date{}
+	assert.NoError(t, dst.UnmarshalYAML(func(v interface{}) error {
+		*v.(*string) = "2018-08-20"
+		return nil
+	}))
+	assert.Equal(t, NewDate(2018, 8, 20), dst)
+	assert.Equal(t, ErrInvalidFormat, dst.UnmarshalYAML(func(interface{}) error {
+		return ErrInvalidFormat
+	}))
+}
+
+func TestDateMarshalTOML(t *testing.T) {
+	t.Parallel()
+	actual, err := NewDate(2018, 8, 20).MarshalTOML()
+	assert.NoError(t, err)
+	assert.Equal(t, "2018-08-20", string(actual))
+	actual, err = MySQLZeroDate().MarshalTOML()
+	assert.NoError(t, err)
+	assert.Equal(t, `"0000-00-00"`, string(actual))
+
+	dst := Date{}
+	assert.NoError(t, dst.UnmarshalTOML(time.Date(2018, 8, 20, 23, 0, 0, 0, time.FixedZone("JST", 9*60*60))))
+	assert.Equal(t, NewDate(2018, 8, 20), dst)
+	assert.NoError(t, dst.UnmarshalTOML("0000-00-00"))
+	assert.True(t, dst.IsMySQLZero())
+	assertInvalidValueType(t, dst.UnmarshalTOML(int64(20180820)))
 }
 
 func TestDateMarshalText(t *testing.T) {
 	now := NowDate()
 	actual, err := now.MarshalText()
 	assert.NoError(t, err)
-	expected, err := now.src.MarshalText()
+	expected, err := now.Time().MarshalText()
 	assert.NoError(t, err)
 	assert.EqualValues(t, expected, actual)
 }
 
 func TestDateValue(t *testing.T) {
 	t.Parallel()
-	dateTime := NowDate()
-	v, err := dateTime.Value()
+	v, err := NewDate(2018, 8, 20).Value()
 	assert.NoError(t, err)
-	assert.Equal(t, dateTime.src, v)
+	assert.Equal(t, "2018-08-20", v)
+
+	_, err = MinDate().AddDate(0, 0, -1).Value()
+	assertOutOfRange(t, err)
+	_, err = MaxDate().AddDate(0, 0, 1).Value()
+	assertOutOfRange(t, err)
+	_, err = Date{}.Value()
+	assertOutOfRange(t, err)
+}
+
+func TestNewDateChecked(t *testing.T) {
+	t.Parallel()
+	v, err := NewDateChecked(9999, 12, 31)
+	assert.NoError(t, err)
+	assert.Equal(t, MaxDate(), v)
+	_, err = NewDateChecked(10000, 1, 1)
+	assertOutOfRange(t, err)
+	_, err = NewDateFromTimeChecked(time.Date(999, 12, 31, 23, 0, 0, 0, time.UTC))
+	assertOutOfRange(t, err)
+	assert.Contains(t, err.Error(), "0999-12-31")
+}
+
+func TestDateUnmarshalOutOfRange(t *testing.T) {
+	t.Parallel()
+	target := MinDate()
+	assertOutOfRange(t, target.UnmarshalText([]byte("0001-01-01T00:00:00Z")))
+	assertOutOfRange(t, json.Unmarshal([]byte(`"0999-12-31T00:00:00Z"`), &target))
+	assert.Equal(t, MinDate(), target)
 }
 
 func TestDateScan(t *testing.T) {
 	t.Parallel()
 	target := Date{}
-	now := NowDate().src
+	now := NowDate().Time()
 	assert.NoError(t, target.Scan(now))
-	assertTimeEquals(t, now, target.src)
+	assertTimeEquals(t, now, target.Time())
 	nowStr := now.Format(dateFormatLayout)
 	nowFromFormat, err := time.Parse(dateFormatLayout, nowStr)
 	assert.NoError(t, err)
 	target2 := Date{}
 	assert.NoError(t, target2.Scan([]byte(nowStr)))
-	assertTimeEquals(t, nowFromFormat, target2.src)
+	assertTimeEquals(t, nowFromFormat, target2.Time())
+}
+
+func TestDateScanVariousValues(t *testing.T) {
+	t.Parallel()
+	expected := NewDate(2018, 8, 20)
+	values := []interface{}{
+		"2018-08-20",
+		[]byte("2018-08-20 10:20:30"),
+		"2018-08-20T10:20:30.123Z",
+		"20180820",
+		`"2018-08-20"`,
+		int64(1534760430),
+	}
+	for _, v := range values {
+		target := Date{}
+		assert.NoError(t, target.Scan(v), "value: %v", v)
+		assert.Equal(t, expected, target, "value: %v", v)
+	}
+
+	target := Date{}
+	assert.Error(t, target.Scan("2018/08/20"))
+	err := target.Scan(1.0)
+	assertInvalidValueType(t, err)
+	assert.Contains(t, err.Error(), "float64")
 }
 
 func TestDateAfterAndBefore(t *testing.T) {
@@ -101,9 +241,10 @@ func TestDateEqual(t *testing.T) {
 func TestDateToTime(t *testing.T) {
 	t.Parallel()
 	min := MinDate().Time()
-	assertTimeEquals(t, MinDate().src, min)
+	assert.Equal(t, time.Date(1000, 1, 1, 0, 0, 0, 0, time.UTC), min)
 	max := MaxDate().Time()
-	assertTimeEquals(t, MaxDate().src, max)
+	assert.Equal(t, time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC), max)
+	assert.Equal(t, time.Time{}, Date{}.Time())
 }
 
 func TestDateTextUnmarshalText(t *testing.T) {
@@ -114,14 +255,14 @@ func TestDateTextUnmarshalText(t *testing.T) {
 	expected := time.Time{}
 	err := expected.UnmarshalText([]byte(text))
 	assert.NoError(t, err)
-	assertTimeEquals(t, expected, target.src)
+	assertTimeEquals(t, expected, target.Time())
 }
 
 func TestDateAddDate(t *testing.T) {
 	t.Parallel()
 	now := NowDate()
-	expected := now.src.AddDate(1, 2, 4)
-	actual := now.AddDate(1, 2, 4).src
+	expected := now.Time().AddDate(1, 2, 4)
+	actual := now.AddDate(1, 2, 4).Time()
 	assertTimeEquals(t, expected, actual)
 }
 
@@ -129,7 +270,7 @@ func TestDateSub(t *testing.T) {
 	t.Parallel()
 	now := NowDate()
 	sub := NewDate(0, 0, 1)
-	expected := now.src.Sub(sub.src)
+	expected := now.Time().Sub(sub.Time())
 	actual := now.Sub(sub)
 	assert.Equal(t, expected, actual)
 }
@@ -138,9 +279,9 @@ func TestDateAdd(t *testing.T) {
 	t.Parallel()
 	now := NowDate()
 	add := 24 * time.Hour * 2
-	expected := now.src.Add(add)
+	expected := now.Time().Add(add)
 	actual := now.Add(add)
-	assert.Equal(t, expected, actual.src)
+	assert.Equal(t, expected, actual.Time())
 }
 
 func TestDateIsZero(t *testing.T) {
@@ -155,7 +296,7 @@ func TestDateIsZero(t *testing.T) {
 func TestDateDate(t *testing.T) {
 	t.Parallel()
 	now := NowDate()
-	expectedYear, expectedMonth, expectedDay := now.src.Date()
+	expectedYear, expectedMonth, expectedDay := now.Time().Date()
 	actualYear, actualMonth, actualDay := now.Date()
 	assert.Equal(t, expectedYear, actualYear)
 	assert.Equal(t, expectedMonth, actualMonth)
@@ -165,38 +306,213 @@ func TestDateDate(t *testing.T) {
 func TestDateYear(t *testing.T) {
 	t.Parallel()
 	max := MaxDate()
-	assert.Equal(t, max.src.Year(), max.Year())
+	assert.Equal(t, max.Time().Year(), max.Year())
 	assert.Equal(t, 9999, max.Year())
 }
 
 func TestDateMonth(t *testing.T) {
 	t.Parallel()
 	max := MaxDate()
-	assert.Equal(t, max.src.Month(), max.Month())
+	assert.Equal(t, max.Time().Month(), max.Month())
 	assert.Equal(t, time.Month(12), max.Month())
 }
 
 func TestDateDay(t *testing.T) {
 	t.Parallel()
 	max := MaxDate()
-	assert.Equal(t, max.src.Day(), max.Day())
+	assert.Equal(t, max.Time().Day(), max.Day())
 	assert.Equal(t, 31, max.Day())
 }
 
 func TestDateWeekDay(t *testing.T) {
 	t.Parallel()
 	max := MaxDate()
-	assert.Equal(t, max.src.Weekday(), max.Weekday())
+	assert.Equal(t, max.Time().Weekday(), max.Weekday())
 	assert.Equal(t, time.Friday, max.Weekday())
 }
 
 func TestDateISOWeek(t *testing.T) {
 	t.Parallel()
 	max := MaxDate()
-	expectedYear, expectedWeek := max.src.ISOWeek()
+	expectedYear, expectedWeek := max.Time().ISOWeek()
 	actualYear, actualWeek := max.ISOWeek()
 	assert.Equal(t, expectedYear, actualYear)
 	assert.Equal(t, expectedWeek, actualWeek)
 	assert.Equal(t, 9999, actualYear)
 	assert.Equal(t, 52, actualWeek)
 }
+
+func TestDateCivilDays(t *testing.T) {
+	t.Parallel()
+	for tm := time.Date(-1, 1, 1, 0, 0, 0, 0, time.UTC); tm.Year() <= 2400; tm = tm.AddDate(0, 0, 1) {
+		dt := NewDateFromTime(tm)
+		year, month, day := dt.Date()
+		if dt.Time() != tm || year != tm.Year() || month != tm.Month() || day != tm.Day() ||
+			dt.Weekday() != tm.Weekday() || dt.YearDay() != tm.YearDay() {
+			t.Fatalf("%v: got %v, %s, %d", tm, dt.Time(), dt.Weekday(), dt.YearDay())
+		}
+	}
+	assert.Equal(t, "9999-12-31", MaxDate().String())
+	assert.Equal(t, NewDate(2019, 3, 1), NewDate(2018, 14, 29))
+	assert.Equal(t, NewDate(2018, 2, 28), NewDate(2018, 3, 0))
+}
+
+func TestDateSize(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, uintptr(8), unsafe.Sizeof(Date{}))
+}
+
+func TestDateAllocs(t *testing.T) {
+	var src interface{} = time.Date(2018, 8, 20, 10, 20, 30, 0, time.UTC)
+	var srcBytes interface{} = []byte("2018-08-20")
+	var srcString interface{} = "2018-08-20"
+	dt := NewDate(2018, 8, 20)
+	u := NewDate(2018, 8, 21)
+	var target Date
+	var buf [16]byte
+	tests := map[string]func(){
+		"NewDate":    func() { dt = NewDate(2018, 8, 20) },
+		"Before":     func() { _ = dt.Before(u) },
+		"Date":       func() { _, _, _ = dt.Date() },
+		"AddDate":    func() { _ = dt.AddDate(0, 1, 1) },
+		"Scan":       func() { _ = target.Scan(src) },
+		"ScanBytes":  func() { _ = target.Scan(srcBytes) },
+		"ScanString": func() { _ = target.Scan(srcString) },
+		"Format":     func() { _ = dt.appendFormat(buf[:0]) },
+	}
+	for name, f := range tests {
+		assert.Equal(t, 0.0, testing.AllocsPerRun(100, f), name)
+	}
+
+	// the only allocations are the results: the returned slice, or the string and its box in driver.Value
+	results := map[string]float64{
+		"Value":       testing.AllocsPerRun(100, func() { _, _ = dt.Value() }),
+		"MarshalText": testing.AllocsPerRun(100, func() { _, _ = dt.MarshalText() }),
+		"MarshalJSON": testing.AllocsPerRun(100, func() { _, _ = dt.MarshalJSON() }),
+	}
+	assert.Equal(t, map[string]float64{"Value": 2, "MarshalText": 1, "MarshalJSON": 1}, results)
+}
+
+// legacyDate previous representation of Date to compare in benchmarks
+type legacyDate struct {
+	src time.Time
+}
+
+func newLegacyDate(year int, month time.Month, day int) legacyDate {
+	return legacyDate{src: time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
+func (dt legacyDate) addDate(years int, months int, days int) legacyDate {
+	t := dt.src.AddDate(years, months, days)
+	return legacyDate{src: time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)}
+}
+
+var (
+	legacyDateSink []legacyDate
+	dateSink       []Date
+)
+
+func BenchmarkDateSlice(b *testing.B) {
+	b.Run("legacy", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			legacyDateSink = make([]legacyDate, 1024)
+			for j := range legacyDateSink {
+				legacyDateSink[j] = newLegacyDate(2018, 8, j)
+			}
+		}
+	})
+	b.Run("compact", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			dateSink = make([]Date, 1024)
+			for j := range dateSink {
+				dateSink[j] = NewDate(2018, 8, j)
+			}
+		}
+	})
+}
+
+func BenchmarkDateBefore(b *testing.B) {
+	b.Run("legacy", func(b *testing.B) {
+		v1, v2 := newLegacyDate(2018, 8, 20), newLegacyDate(2018, 8, 21)
+		for i := 0; i < b.N; i++ {
+			_ = v1.src.Before(v2.src)
+		}
+	})
+	b.Run("compact", func(b *testing.B) {
+		v1, v2 := NewDate(2018, 8, 20), NewDate(2018, 8, 21)
+		for i := 0; i < b.N; i++ {
+			_ = v1.Before(v2)
+		}
+	})
+}
+
+func BenchmarkDateAddDate(b *testing.B) {
+	b.Run("legacy", func(b *testing.B) {
+		dt := newLegacyDate(2018, 8, 20)
+		for i := 0; i < b.N; i++ {
+			_ = dt.addDate(0, 0, 1)
+		}
+	})
+	b.Run("compact", func(b *testing.B) {
+		dt := NewDate(2018, 8, 20)
+		for i := 0; i < b.N; i++ {
+			_ = dt.AddDate(0, 0, 1)
+		}
+	})
+}
+
+func BenchmarkDateScan(b *testing.B) {
+	var src interface{} = time.Date(2018, 8, 20, 10, 20, 30, 0, time.UTC)
+	b.Run("legacy", func(b *testing.B) {
+		b.ReportAllocs()
+		var dt legacyDate
+		for i := 0; i < b.N; i++ {
+			t := src.(time.Time)
+			dt = newLegacyDate(t.Year(), t.Month(), t.Day())
+		}
+		_ = dt
+	})
+	b.Run("compact", func(b *testing.B) {
+		b.ReportAllocs()
+		var dt Date
+		for i := 0; i < b.N; i++ {
+			_ = dt.Scan(src)
+		}
+	})
+}
+
+func BenchmarkDateMarshalText(b *testing.B) {
+	b.Run("legacy", func(b *testing.B) {
+		b.ReportAllocs()
+		dt := newLegacyDate(2018, 8, 20)
+		for i := 0; i < b.N; i++ {
+			_, _ = dt.src.MarshalText()
+		}
+	})
+	b.Run("compact", func(b *testing.B) {
+		b.ReportAllocs()
+		dt := NewDate(2018, 8, 20)
+		for i := 0; i < b.N; i++ {
+			_, _ = dt.MarshalText()
+		}
+	})
+}
+
+func BenchmarkDateString(b *testing.B) {
+	b.Run("legacy", func(b *testing.B) {
+		b.ReportAllocs()
+		dt := newLegacyDate(2018, 8, 20)
+		for i := 0; i < b.N; i++ {
+			_ = dt.src.Format(dateFormatLayout)
+		}
+	})
+	b.Run("compact", func(b *testing.B) {
+		b.ReportAllocs()
+		dt := NewDate(2018, 8, 20)
+		for i := 0; i < b.N; i++ {
+			_ = dt.String()
+		}
+	})
+}
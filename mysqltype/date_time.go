@@ -5,13 +5,16 @@ import (
 	"database/sql/driver"
 	"encoding"
 	"encoding/json"
+	"encoding/xml"
 	"time"
 )
 
 // DateTime support MySQL DateTime type
 // https://dev.mysql.com/doc/refman/8.0/en/datetime.html
+// Like Value, marshallers reject times out of DATETIME range, which unmarshallers would reject too.
 type DateTime struct {
-	src time.Time `gorm:"type:datetime"`
+	src  time.Time `gorm:"type:datetime"`
+	zero bool
 }
 
 // NewDateTime Create new DateTime from time.Date
@@ -25,14 +28,36 @@ func NewDateTimeFromTime(t time.Time) DateTime {
 	return DateTime{src: t}
 }
 
-// MinDateTime Minimum DateTime
+// NewDateTimeChecked Create new DateTime from time.Date
+// It returns OutOfRangeError if the time is not in MySQL DATETIME range.
+func NewDateTimeChecked(year int, month time.Month, day, hour, min, sec, nsec int, loc *time.Location) (DateTime, error) {
+	dt := NewDateTime(year, month, day, hour, min, sec, nsec, loc)
+	if err := dt.validate(); err != nil {
+		return DateTime{}, err
+	}
+	return dt, nil
+}
+
+// NewDateTimeFromTimeChecked Create new DateTime from Time
+// It returns OutOfRangeError if the time is not in MySQL DATETIME range.
+func NewDateTimeFromTimeChecked(t time.Time) (DateTime, error) {
+	dt := NewDateTimeFromTime(t)
+	if err := dt.validate(); err != nil {
+		return DateTime{}, err
+	}
+	return dt, nil
+}
+
+// MinDateTime Minimum DateTime, 1000-01-01 00:00:00 in the location set by SetLocation
 func MinDateTime() DateTime {
-	return NewDateTimeFromTime(time.Date(1000, 1, 1, 0, 0, 0, 0, time.UTC))
+	return NewDateTime(1000, 1, 1, 0, 0, 0, 0, location)
 }
 
-// MaxDateTime Maximum DateTime
+// MaxDateTime Maximum DateTime, 9999-12-31 23:59:59.999999 in the location set by SetLocation
+// It has the microseconds of DATETIME(6), so truncate it to the seconds to save into DATETIME columns,
+// which MySQL would round up out of range.
 func MaxDateTime() DateTime {
-	return NewDateTimeFromTime(time.Date(9999, 12, 31, 23, 59, 59, 999999, time.UTC))
+	return NewDateTime(9999, 12, 31, 23, 59, 59, 999999000, location)
 }
 
 // NowDateTime Create Now time for MySQL DataBase
@@ -53,7 +78,7 @@ func (dt DateTime) Before(u DateTime) bool {
 
 // Equal behavior as time.Time
 func (dt DateTime) Equal(u DateTime) bool {
-	return dt.src.Equal(u.src)
+	return dt.zero == u.zero && dt.src.Equal(u.src)
 }
 
 // Time convert to time.Time
@@ -116,6 +141,32 @@ func (dt DateTime) IsZero() bool {
 	return dt.src.IsZero()
 }
 
+// IsMySQLZero reports whether dt is MySQL zero date
+func (dt DateTime) IsMySQLZero() bool {
+	return dt.zero
+}
+
+// IsValid reports whether dt is in MySQL DATETIME range
+// It checks the wall clock in the location set by SetLocation, which Value sends.
+func (dt DateTime) IsValid() bool {
+	return NewLocalDateTimeFromTime(dt.src.In(location)).IsValid()
+}
+
+func (dt DateTime) validate() error {
+	if !dt.zero && !dt.IsValid() {
+		return &OutOfRangeError{Value: dt, Min: MinDateTime(), Max: MaxDateTime()}
+	}
+	return nil
+}
+
+// String behavior as time.Time
+func (dt DateTime) String() string {
+	if dt.zero {
+		return mySQLZeroDateTime
+	}
+	return dt.src.String()
+}
+
 // Date  behavior as time.Time
 func (dt DateTime) Date() (year int, month time.Month, day int) {
 	return dt.src.Date()
@@ -183,32 +234,195 @@ func (dt DateTime) YearDay() int {
 
 // UnmarshalText behavior as time.Time
 func (dt *DateTime) UnmarshalText(text []byte) error {
-	return dt.src.UnmarshalText(text)
+	if string(text) == mySQLZeroDateTime {
+		*dt = MySQLZeroDateTime()
+		return nil
+	}
+	t := time.Time{}
+
+	if err := t.UnmarshalText(text); err != nil {
+		return err
+	}
+	return dt.setChecked(t)
 }
 
 // MarshalText behavior as time.Time
 func (dt DateTime) MarshalText() ([]byte, error) {
+	if err := dt.validate(); err != nil {
+		return nil, err
+	}
+	if dt.zero {
+		return []byte(mySQLZeroDateTime), nil
+	}
 	return dt.src.MarshalText()
 }
 
 // UnmarshalBinary behavior as time.Time
 func (dt *DateTime) UnmarshalBinary(data []byte) error {
-	return dt.src.UnmarshalBinary(data)
+	if string(data) == mySQLZeroDateTime {
+		*dt = MySQLZeroDateTime()
+		return nil
+	}
+	t := time.Time{}
+
+	if err := t.UnmarshalBinary(data); err != nil {
+		return err
+	}
+	return dt.setChecked(t)
 }
 
 // MarshalBinary behavior as time.Time
+// MySQL zero date is encoded as its text, which is never valid binary of time.Time.
 func (dt DateTime) MarshalBinary() ([]byte, error) {
+	if err := dt.validate(); err != nil {
+		return nil, err
+	}
+	if dt.zero {
+		return []byte(mySQLZeroDateTime), nil
+	}
 	return dt.src.MarshalBinary()
 }
 
-// UnmarshalJSON behavior as time.Time
+// UnmarshalJSON parse JSON in the format set by SetDateTimeJSONFormat
+// It also accepts RFC 3339 string as time.Time.
 func (dt *DateTime) UnmarshalJSON(data []byte) error {
-	return dt.src.UnmarshalJSON(data)
+	return dt.unmarshalJSONWith(data, dateTimeJSONFormat)
 }
 
-// MarshalJSON behavior as time.Time
+// MarshalJSON format in the format set by SetDateTimeJSONFormat, RFC 3339 as time.Time by default
 func (dt DateTime) MarshalJSON() ([]byte, error) {
-	return dt.src.MarshalJSON()
+	return dt.marshalJSONWith(dateTimeJSONFormat)
+}
+
+func (dt *DateTime) unmarshalJSONWith(data []byte, f JSONFormat) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if string(data) == `"`+mySQLZeroDateTime+`"` {
+		*dt = MySQLZeroDateTime()
+		return nil
+	}
+	t, err := unmarshalJSONTime(data, f, rfc3339JSONFormat{})
+	if err != nil {
+		return err
+	}
+	return dt.setChecked(t)
+}
+
+func (dt DateTime) marshalJSONWith(f JSONFormat) ([]byte, error) {
+	if err := dt.validate(); err != nil {
+		return nil, err
+	}
+	if dt.zero {
+		return []byte(`"` + mySQLZeroDateTime + `"`), nil
+	}
+	return f.MarshalJSONTime(dt.src)
+}
+
+// GobEncode encode as MarshalBinary
+func (dt DateTime) GobEncode() ([]byte, error) {
+	return dt.MarshalBinary()
+}
+
+// GobDecode decode as UnmarshalBinary
+func (dt *DateTime) GobDecode(data []byte) error {
+	return dt.UnmarshalBinary(data)
+}
+
+// MarshalXML format as RFC 3339 element as MarshalText
+func (dt DateTime) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	s, err := dt.naturalText()
+	if err != nil {
+		return err
+	}
+	return e.EncodeElement(s, start)
+}
+
+// UnmarshalXML parse element as UnmarshalYAML
+func (dt *DateTime) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	s, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	return dt.parseNaturalText(s)
+}
+
+// MarshalXMLAttr format as RFC 3339 attribute as MarshalText
+func (dt DateTime) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	s, err := dt.naturalText()
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: s}, nil
+}
+
+// UnmarshalXMLAttr parse attribute as UnmarshalYAML
+func (dt *DateTime) UnmarshalXMLAttr(attr xml.Attr) error {
+	return dt.parseNaturalText(attr.Value)
+}
+
+// MarshalYAML format as RFC 3339 string as MarshalText
+func (dt DateTime) MarshalYAML() (interface{}, error) {
+	return dt.naturalText()
+}
+
+// UnmarshalYAML parse RFC 3339, "0000-00-00 00:00:00" or date and time string accepted by Scan
+// Text without time zone is parsed in the location set by SetLocation.
+func (dt *DateTime) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	s, err := unmarshalYAMLText(unmarshal)
+	if err != nil {
+		return err
+	}
+	return dt.parseNaturalText(s)
+}
+
+// MarshalTOML format as TOML offset date-time, e.g. 2018-08-20T10:20:30.123Z
+// MySQL zero date is formatted as TOML string, since it is not a valid TOML date-time.
+func (dt DateTime) MarshalTOML() ([]byte, error) {
+	s, err := dt.naturalText()
+	if err != nil {
+		return nil, err
+	}
+	if dt.zero {
+		return quoteTOML(s), nil
+	}
+	return []byte(s), nil
+}
+
+// UnmarshalTOML take TOML date and time, or parse string as UnmarshalYAML
+// TOML local date-time is taken in the location set by SetLocation.
+func (dt *DateTime) UnmarshalTOML(v interface{}) error {
+	switch v := v.(type) {
+	case time.Time:
+		return dt.setChecked(tomlLocalTime(v))
+	case string:
+		return dt.parseNaturalText(v)
+	default:
+		return &InvalidValueTypeError{Value: v, Target: "DateTime"}
+	}
+}
+
+// naturalText format as RFC 3339 with nanoseconds for XML, YAML and TOML
+func (dt DateTime) naturalText() (string, error) {
+	if err := dt.validate(); err != nil {
+		return "", err
+	}
+	if dt.zero {
+		return mySQLZeroDateTime, nil
+	}
+	return dt.src.Format(time.RFC3339Nano), nil
+}
+
+func (dt *DateTime) parseNaturalText(s string) error {
+	if isMySQLZeroLiteral(s) {
+		*dt = MySQLZeroDateTime()
+		return nil
+	}
+	t, err := parseTimeText(s, dateTimeLayouts)
+	if err != nil {
+		return err
+	}
+	return dt.setChecked(t)
 }
 
 const dateTimeFormatLayout = "2006-01-02 15:04:05.999999999"
@@ -224,29 +438,38 @@ var _ json.Unmarshaler = &DateTime{}
 
 // Scan for sql.Scanner
 func (dt *DateTime) Scan(value interface{}) error {
-	src, ok := value.(time.Time)
-
-	var dst DateTime
-	if ok {
-
-		dst = NewDateTimeFromTime(src)
-
-	} else {
-		src, ok := value.([]byte)
-		if !ok {
-			return ErrInvalidValueType
-		}
-		t, err := time.Parse(dateTimeFormatLayout, string(src))
+	if isMySQLZeroValue(value) {
+		sentinel, err := scanMySQLZero()
 		if err != nil {
 			return err
 		}
-		dst = NewDateTimeFromTime(t)
+		*dt = DateTime{zero: sentinel}
+		return nil
 	}
-	dt.src = dst.src
+	t, err := scanTime(value, "DateTime", dateTimeLayouts)
+	if err != nil {
+		return err
+	}
+	*dt = NewDateTimeFromTime(t.In(location))
 	return nil
 }
 
 // Value for driver.Valuer
 func (dt DateTime) Value() (driver.Value, error) {
-	return dt.src, nil
+	if dt.zero {
+		return mySQLZeroDateTime, nil
+	}
+	if err := dt.validate(); err != nil {
+		return nil, err
+	}
+	return dt.src.In(location), nil
+}
+
+func (dt *DateTime) setChecked(t time.Time) error {
+	dst := NewDateTimeFromTime(t)
+	if err := dst.validate(); err != nil {
+		return err
+	}
+	*dt = dst
+	return nil
 }
@@ -0,0 +1,217 @@
+package mysqltype
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"strconv"
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+// FractionalMode how to drop fractional seconds exceeding the column precision
+// https://dev.mysql.com/doc/refman/8.0/en/fractional-seconds.html
+type FractionalMode int
+
+const (
+	// FractionalRound round fractional seconds as MySQL does by default
+	FractionalRound FractionalMode = iota
+	// FractionalTruncate truncate fractional seconds as MySQL does with TIME_TRUNCATE_FRACTIONAL
+	FractionalTruncate
+)
+
+const maxFractionalPrecision = 6
+
+var fractionalMode = FractionalRound
+
+// SetFractionalMode set how DateTime0 to DateTime6 drop fractional seconds in Value
+// It should match sql_mode of the server, and is not safe to call concurrently with Value.
+func SetFractionalMode(mode FractionalMode) {
+	fractionalMode = mode
+}
+
+// AdjustPrecision round or truncate fractional seconds to fsp digits according to FractionalMode
+// fsp is clamped to 0..6.
+func (dt DateTime) AdjustPrecision(fsp int) DateTime {
+	if dt.zero {
+		return dt
+	}
+	if fsp < 0 {
+		fsp = 0
+	} else if fsp > maxFractionalPrecision {
+		fsp = maxFractionalPrecision
+	}
+	d := time.Second
+	for i := 0; i < fsp; i++ {
+		d /= 10
+	}
+	if fractionalMode == FractionalTruncate {
+		return dt.Truncate(d)
+	}
+	return dt.Round(d)
+}
+
+// fspValue Value of dt with fractional seconds adjusted to fsp digits
+func fspValue(dt DateTime, fsp int) (driver.Value, error) {
+	return dt.AdjustPrecision(fsp).Value()
+}
+
+// fspDataType column type of DATETIME(fsp)
+func fspDataType(fsp int) string {
+	return "datetime(" + strconv.Itoa(fsp) + ")"
+}
+
+// DateTime0 DateTime stored in DATETIME(0)
+type DateTime0 struct {
+	DateTime
+}
+
+// NewDateTime0 Create new DateTime0 from DateTime
+func NewDateTime0(dt DateTime) DateTime0 {
+	return DateTime0{DateTime: dt.AdjustPrecision(0)}
+}
+
+// Value for driver.Valuer
+func (dt DateTime0) Value() (driver.Value, error) {
+	return fspValue(dt.DateTime, 0)
+}
+
+// GormDataType column type for gorm AutoMigrate
+func (DateTime0) GormDataType(gorm.Dialect) string {
+	return fspDataType(0)
+}
+
+// DateTime1 DateTime stored in DATETIME(1)
+type DateTime1 struct {
+	DateTime
+}
+
+// NewDateTime1 Create new DateTime1 from DateTime
+func NewDateTime1(dt DateTime) DateTime1 {
+	return DateTime1{DateTime: dt.AdjustPrecision(1)}
+}
+
+// Value for driver.Valuer
+func (dt DateTime1) Value() (driver.Value, error) {
+	return fspValue(dt.DateTime, 1)
+}
+
+// GormDataType column type for gorm AutoMigrate
+func (DateTime1) GormDataType(gorm.Dialect) string {
+	return fspDataType(1)
+}
+
+// DateTime2 DateTime stored in DATETIME(2)
+type DateTime2 struct {
+	DateTime
+}
+
+// NewDateTime2 Create new DateTime2 from DateTime
+func NewDateTime2(dt DateTime) DateTime2 {
+	return DateTime2{DateTime: dt.AdjustPrecision(2)}
+}
+
+// Value for driver.Valuer
+func (dt DateTime2) Value() (driver.Value, error) {
+	return fspValue(dt.DateTime, 2)
+}
+
+// GormDataType column type for gorm AutoMigrate
+func (DateTime2) GormDataType(gorm.Dialect) string {
+	return fspDataType(2)
+}
+
+// DateTime3 DateTime stored in DATETIME(3)
+type DateTime3 struct {
+	DateTime
+}
+
+// NewDateTime3 Create new DateTime3 from DateTime
+func NewDateTime3(dt DateTime) DateTime3 {
+	return DateTime3{DateTime: dt.AdjustPrecision(3)}
+}
+
+// Value for driver.Valuer
+func (dt DateTime3) Value() (driver.Value, error) {
+	return fspValue(dt.DateTime, 3)
+}
+
+// GormDataType column type for gorm AutoMigrate
+func (DateTime3) GormDataType(gorm.Dialect) string {
+	return fspDataType(3)
+}
+
+// DateTime4 DateTime stored in DATETIME(4)
+type DateTime4 struct {
+	DateTime
+}
+
+// NewDateTime4 Create new DateTime4 from DateTime
+func NewDateTime4(dt DateTime) DateTime4 {
+	return DateTime4{DateTime: dt.AdjustPrecision(4)}
+}
+
+// Value for driver.Valuer
+func (dt DateTime4) Value() (driver.Value, error) {
+	return fspValue(dt.DateTime, 4)
+}
+
+// GormDataType column type for gorm AutoMigrate
+func (DateTime4) GormDataType(gorm.Dialect) string {
+	return fspDataType(4)
+}
+
+// DateTime5 DateTime stored in DATETIME(5)
+type DateTime5 struct {
+	DateTime
+}
+
+// NewDateTime5 Create new DateTime5 from DateTime
+func NewDateTime5(dt DateTime) DateTime5 {
+	return DateTime5{DateTime: dt.AdjustPrecision(5)}
+}
+
+// Value for driver.Valuer
+func (dt DateTime5) Value() (driver.Value, error) {
+	return fspValue(dt.DateTime, 5)
+}
+
+// GormDataType column type for gorm AutoMigrate
+func (DateTime5) GormDataType(gorm.Dialect) string {
+	return fspDataType(5)
+}
+
+// DateTime6 DateTime stored in DATETIME(6)
+type DateTime6 struct {
+	DateTime
+}
+
+// NewDateTime6 Create new DateTime6 from DateTime
+func NewDateTime6(dt DateTime) DateTime6 {
+	return DateTime6{DateTime: dt.AdjustPrecision(6)}
+}
+
+// Value for driver.Valuer
+func (dt DateTime6) Value() (driver.Value, error) {
+	return fspValue(dt.DateTime, 6)
+}
+
+// GormDataType column type for gorm AutoMigrate
+func (DateTime6) GormDataType(gorm.Dialect) string {
+	return fspDataType(6)
+}
+
+var _ driver.Valuer = DateTime0{}
+var _ driver.Valuer = DateTime1{}
+var _ driver.Valuer = DateTime2{}
+var _ driver.Valuer = DateTime3{}
+var _ driver.Valuer = DateTime4{}
+var _ driver.Valuer = DateTime5{}
+var _ driver.Valuer = DateTime6{}
+var _ sql.Scanner = &DateTime0{}
+var _ sql.Scanner = &DateTime1{}
+var _ sql.Scanner = &DateTime2{}
+var _ sql.Scanner = &DateTime3{}
+var _ sql.Scanner = &DateTime4{}
+var _ sql.Scanner = &DateTime5{}
+var _ sql.Scanner = &DateTime6{}
@@ -0,0 +1,102 @@
+package mysqltype
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type DateTimePrecisionFieldTestStruct struct {
+	ID        int
+	Target0   DateTime0 `gorm:"not null"`
+	Target3   DateTime3 `gorm:"not null"`
+	Target6   DateTime6 `gorm:"not null"`
+	NullableP *DateTime6
+}
+
+func TestDateTimePrecisionField(t *testing.T) {
+	t.Parallel()
+	now := NowDateTime()
+	target := &DateTimePrecisionFieldTestStruct{
+		Target0: NewDateTime0(now),
+		Target3: NewDateTime3(now),
+		Target6: NewDateTime6(now),
+	}
+	assert.NoError(t, DB.AutoMigrate(target).Error)
+	assert.NoError(t, DB.Create(target).Error)
+
+	dst := &DateTimePrecisionFieldTestStruct{ID: target.ID}
+	assert.NoError(t, DB.First(dst).Error)
+	assertTimeEquals(t, target.Target0.src, dst.Target0.src)
+	assertTimeEquals(t, target.Target3.src, dst.Target3.src)
+	assertTimeEquals(t, target.Target6.src, dst.Target6.src)
+	assert.Nil(t, dst.NullableP)
+}
+
+func TestDateTimeAdjustPrecision(t *testing.T) {
+	v := NewDateTime(2008, 10, 12, 10, 3, 9, 123456789, time.UTC)
+	tests := []struct {
+		fsp       int
+		rounded   int
+		truncated int
+	}{
+		{0, 0, 0},
+		{1, 100000000, 100000000},
+		{3, 123000000, 123000000},
+		{4, 123500000, 123400000},
+		{6, 123457000, 123456000},
+		{7, 123457000, 123456000},
+	}
+	defer SetFractionalMode(FractionalRound)
+	for _, tt := range tests {
+		SetFractionalMode(FractionalRound)
+		assert.Equal(t, tt.rounded, v.AdjustPrecision(tt.fsp).Nanosecond(), "fsp: %d", tt.fsp)
+		SetFractionalMode(FractionalTruncate)
+		assert.Equal(t, tt.truncated, v.AdjustPrecision(tt.fsp).Nanosecond(), "fsp: %d", tt.fsp)
+	}
+
+	SetFractionalMode(FractionalRound)
+	up := NewDateTime(2008, 10, 12, 10, 3, 9, 500000000, time.UTC)
+	assertTimeEquals(t, up.Add(500*time.Millisecond).src, up.AdjustPrecision(0).src)
+	assert.True(t, MySQLZeroDateTime().AdjustPrecision(0).IsMySQLZero())
+}
+
+func TestDateTimePrecisionValue(t *testing.T) {
+	t.Parallel()
+	v := NewDateTime(2008, 10, 12, 10, 3, 9, 123456789, time.UTC)
+
+	actual, err := DateTime3{DateTime: v}.Value()
+	assert.NoError(t, err)
+	assertTimeEquals(t, time.Date(2008, 10, 12, 10, 3, 9, 123000000, time.UTC), actual.(time.Time))
+
+	actual, err = DateTime6{DateTime: v}.Value()
+	assert.NoError(t, err)
+	assertTimeEquals(t, time.Date(2008, 10, 12, 10, 3, 9, 123457000, time.UTC), actual.(time.Time))
+
+	_, err = DateTime0{DateTime: MaxDateTime().Add(time.Second)}.Value()
+	assertOutOfRange(t, err)
+}
+
+func TestDateTimePrecisionGormDataType(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "datetime(0)", DateTime0{}.GormDataType(nil))
+	assert.Equal(t, "datetime(1)", DateTime1{}.GormDataType(nil))
+	assert.Equal(t, "datetime(2)", DateTime2{}.GormDataType(nil))
+	assert.Equal(t, "datetime(3)", DateTime3{}.GormDataType(nil))
+	assert.Equal(t, "datetime(4)", DateTime4{}.GormDataType(nil))
+	assert.Equal(t, "datetime(5)", DateTime5{}.GormDataType(nil))
+	assert.Equal(t, "datetime(6)", DateTime6{}.GormDataType(nil))
+}
+
+func TestNewDateTimePrecision(t *testing.T) {
+	t.Parallel()
+	v := NewDateTime(2008, 10, 12, 10, 3, 9, 123456789, time.UTC)
+	assert.Equal(t, 0, NewDateTime0(v).Nanosecond())
+	assert.Equal(t, 100000000, NewDateTime1(v).Nanosecond())
+	assert.Equal(t, 120000000, NewDateTime2(v).Nanosecond())
+	assert.Equal(t, 123000000, NewDateTime3(v).Nanosecond())
+	assert.Equal(t, 123500000, NewDateTime4(v).Nanosecond())
+	assert.Equal(t, 123460000, NewDateTime5(v).Nanosecond())
+	assert.Equal(t, 123457000, NewDateTime6(v).Nanosecond())
+}
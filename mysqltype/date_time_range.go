@@ -0,0 +1,232 @@
+package mysqltype
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+// DateTimeRange range of DateTime, Start is inclusive and End is exclusive
+// Invalid Start or End means the range is unbounded on that side, so the zero value contains every time.
+// The range is empty if Start is not before End.
+//
+// Embed it to store the range in two DATETIME columns, e.g. period_start and period_end:
+//
+//	Period DateTimeRange `gorm:"embedded;embedded_prefix:period_"`
+type DateTimeRange struct {
+	Start NullDateTime `json:"start"`
+	End   NullDateTime `json:"end"`
+}
+
+// NewDateTimeRange Create new DateTimeRange of [start, end)
+func NewDateTimeRange(start, end DateTime) DateTimeRange {
+	return DateTimeRange{Start: NewNullDateTime(start), End: NewNullDateTime(end)}
+}
+
+// NewDateTimeRangeFrom Create new DateTimeRange of [start, +∞)
+func NewDateTimeRangeFrom(start DateTime) DateTimeRange {
+	return DateTimeRange{Start: NewNullDateTime(start)}
+}
+
+// NewDateTimeRangeUntil Create new DateTimeRange of (-∞, end)
+func NewDateTimeRangeUntil(end DateTime) DateTimeRange {
+	return DateTimeRange{End: NewNullDateTime(end)}
+}
+
+// IsEmpty reports whether r contains no time
+func (r DateTimeRange) IsEmpty() bool {
+	return r.Start.Valid && r.End.Valid && !r.Start.DateTime.Before(r.End.DateTime)
+}
+
+// IsBounded reports whether both Start and End are valid
+func (r DateTimeRange) IsBounded() bool {
+	return r.Start.Valid && r.End.Valid
+}
+
+// Equal reports whether r and u contain same times
+func (r DateTimeRange) Equal(u DateTimeRange) bool {
+	if r.IsEmpty() || u.IsEmpty() {
+		return r.IsEmpty() == u.IsEmpty()
+	}
+	return r.Start.Equal(u.Start) && r.End.Equal(u.End)
+}
+
+// Duration length of r
+// It reports false if r is unbounded.
+func (r DateTimeRange) Duration() (time.Duration, bool) {
+	if !r.IsBounded() {
+		return 0, false
+	}
+	if r.IsEmpty() {
+		return 0, true
+	}
+	return r.End.DateTime.Sub(r.Start.DateTime), true
+}
+
+// Contains reports whether dt is in r
+func (r DateTimeRange) Contains(dt DateTime) bool {
+	return (!r.Start.Valid || !dt.Before(r.Start.DateTime)) && (!r.End.Valid || dt.Before(r.End.DateTime))
+}
+
+// ContainsRange reports whether every time in u is in r
+func (r DateTimeRange) ContainsRange(u DateTimeRange) bool {
+	if u.IsEmpty() {
+		return true
+	}
+	startOK := !r.Start.Valid || (u.Start.Valid && !u.Start.DateTime.Before(r.Start.DateTime))
+	endOK := !r.End.Valid || (u.End.Valid && !u.End.DateTime.After(r.End.DateTime))
+	return startOK && endOK
+}
+
+// Overlaps reports whether r and u have any time in common
+// Adjoining ranges such as [10:00, 11:00) and [11:00, 12:00) do not overlap.
+func (r DateTimeRange) Overlaps(u DateTimeRange) bool {
+	return !r.Intersect(u).IsEmpty()
+}
+
+// Intersect range of times in both r and u
+func (r DateTimeRange) Intersect(u DateTimeRange) DateTimeRange {
+	dst := r
+	if !dst.Start.Valid || (u.Start.Valid && u.Start.DateTime.After(dst.Start.DateTime)) {
+		dst.Start = u.Start
+	}
+	if !dst.End.Valid || (u.End.Valid && u.End.DateTime.Before(dst.End.DateTime)) {
+		dst.End = u.End
+	}
+	return dst
+}
+
+// Bucket unit to split DateTimeRange
+type Bucket int
+
+const (
+	// BucketHour split at every hour
+	BucketHour Bucket = iota
+	// BucketDay split at every midnight
+	BucketDay
+	// BucketWeek split at every midnight of Monday
+	BucketWeek
+)
+
+// Split r at every boundary of bucket in the location of Start
+// The first and last ranges may be shorter than bucket.
+// It returns ErrUnboundedRange if r is unbounded.
+func (r DateTimeRange) Split(bucket Bucket) ([]DateTimeRange, error) {
+	if !r.IsBounded() {
+		return nil, ErrUnboundedRange
+	}
+	if r.IsEmpty() {
+		return nil, nil
+	}
+	var dst []DateTimeRange
+	start := r.Start.DateTime
+	for start.Before(r.End.DateTime) {
+		end := bucket.next(start)
+		if !end.Before(r.End.DateTime) {
+			end = r.End.DateTime
+		}
+		dst = append(dst, NewDateTimeRange(start, end))
+		start = end
+	}
+	return dst, nil
+}
+
+// next start of the bucket after dt
+func (b Bucket) next(dt DateTime) DateTime {
+	year, month, day := dt.Date()
+	switch b {
+	case BucketDay:
+		return NewDateTime(year, month, day+1, 0, 0, 0, 0, dt.Location())
+	case BucketWeek:
+		days := (7 - int(dt.Weekday()-time.Monday)) % 7
+		if days == 0 {
+			days = 7
+		}
+		return NewDateTime(year, month, day+days, 0, 0, 0, 0, dt.Location())
+	default:
+		// hours are elapsed time from the hour of the wall clock, so that DST transitions neither skip nor repeat a bucket
+		_, offset := dt.src.Zone()
+		d := time.Duration(offset) * time.Second
+		return NewDateTimeFromTime(dt.src.Add(d).Truncate(time.Hour).Add(time.Hour - d))
+	}
+}
+
+// OverlapsScope gorm scope to find rows whose [startColumn, endColumn) overlaps r
+// NULL columns mean unbounded, and each column is compared by a single range condition so that its index can be used.
+//
+//	db.Scopes(mysqltype.OverlapsScope("starts_at", "ends_at", r)).Find(&bookings)
+func OverlapsScope(startColumn, endColumn string, r DateTimeRange) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		query, args := overlapsCondition(db.NewScope(nil).Quote, startColumn, endColumn, r)
+		return db.Where(query, args...)
+	}
+}
+
+// ContainsScope gorm scope to find rows whose [startColumn, endColumn) contains dt
+// NULL columns mean unbounded.
+func ContainsScope(startColumn, endColumn string, dt DateTime) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		query, args := containsCondition(db.NewScope(nil).Quote, startColumn, endColumn, dt)
+		return db.Where(query, args...)
+	}
+}
+
+// WithinScope gorm scope to find rows whose [startColumn, endColumn) is in r
+// NULL columns mean unbounded.
+func WithinScope(startColumn, endColumn string, r DateTimeRange) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		query, args := withinCondition(db.NewScope(nil).Quote, startColumn, endColumn, r)
+		return db.Where(query, args...)
+	}
+}
+
+func overlapsCondition(quote func(string) string, startColumn, endColumn string, r DateTimeRange) (string, []interface{}) {
+	if r.IsEmpty() {
+		return "1 = 0", nil
+	}
+	var conditions []string
+	var args []interface{}
+	if r.End.Valid {
+		conditions = append(conditions, "("+quote(startColumn)+" IS NULL OR "+quote(startColumn)+" < ?)")
+		args = append(args, r.End.DateTime)
+	}
+	if r.Start.Valid {
+		conditions = append(conditions, "("+quote(endColumn)+" IS NULL OR "+quote(endColumn)+" > ?)")
+		args = append(args, r.Start.DateTime)
+	}
+	return joinConditions(conditions), args
+}
+
+func containsCondition(quote func(string) string, startColumn, endColumn string, dt DateTime) (string, []interface{}) {
+	query := "(" + quote(startColumn) + " IS NULL OR " + quote(startColumn) + " <= ?) AND (" +
+		quote(endColumn) + " IS NULL OR " + quote(endColumn) + " > ?)"
+	return query, []interface{}{dt, dt}
+}
+
+func withinCondition(quote func(string) string, startColumn, endColumn string, r DateTimeRange) (string, []interface{}) {
+	if r.IsEmpty() {
+		return "1 = 0", nil
+	}
+	var conditions []string
+	var args []interface{}
+	if r.Start.Valid {
+		conditions = append(conditions, quote(startColumn)+" >= ?")
+		args = append(args, r.Start.DateTime)
+	}
+	if r.End.Valid {
+		conditions = append(conditions, quote(endColumn)+" <= ?")
+		args = append(args, r.End.DateTime)
+	}
+	return joinConditions(conditions), args
+}
+
+func joinConditions(conditions []string) string {
+	if len(conditions) == 0 {
+		return "1 = 1"
+	}
+	query := conditions[0]
+	for _, c := range conditions[1:] {
+		query += " AND " + c
+	}
+	return query
+}
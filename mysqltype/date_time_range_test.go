@@ -0,0 +1,218 @@
+package mysqltype
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+)
+
+type DateTimeRangeFieldTestStruct struct {
+	ID     int
+	Period DateTimeRange `gorm:"embedded;embedded_prefix:period_"`
+}
+
+func TestDateTimeRangeField(t *testing.T) {
+	t.Parallel()
+	targets := []*DateTimeRangeFieldTestStruct{
+		{Period: NewDateTimeRange(NewDateTime(2018, 8, 20, 10, 0, 0, 0, time.UTC), NewDateTime(2018, 8, 20, 11, 0, 0, 0, time.UTC))},
+		{Period: NewDateTimeRange(NewDateTime(2018, 8, 20, 11, 0, 0, 0, time.UTC), NewDateTime(2018, 8, 20, 12, 0, 0, 0, time.UTC))},
+		{Period: NewDateTimeRangeFrom(NewDateTime(2018, 8, 20, 12, 0, 0, 0, time.UTC))},
+	}
+	assert.NoError(t, DB.AutoMigrate(targets[0]).Error)
+	for _, target := range targets {
+		assert.NoError(t, DB.Create(target).Error)
+	}
+	ids := []int{targets[0].ID, targets[1].ID, targets[2].ID}
+
+	dst := &DateTimeRangeFieldTestStruct{ID: targets[2].ID}
+	assert.NoError(t, DB.First(dst).Error)
+	assert.True(t, targets[2].Period.Equal(dst.Period))
+
+	tests := []struct {
+		scope    func(*gorm.DB) *gorm.DB
+		expected []int
+	}{
+		{OverlapsScope("period_start", "period_end", NewDateTimeRange(NewDateTime(2018, 8, 20, 10, 30, 0, 0, time.UTC), NewDateTime(2018, 8, 20, 11, 0, 0, 0, time.UTC))), ids[:1]},
+		{OverlapsScope("period_start", "period_end", NewDateTimeRangeFrom(NewDateTime(2018, 8, 20, 11, 0, 0, 0, time.UTC))), ids[1:]},
+		{ContainsScope("period_start", "period_end", NewDateTime(2018, 8, 20, 11, 0, 0, 0, time.UTC)), ids[1:2]},
+		{ContainsScope("period_start", "period_end", NewDateTime(2100, 1, 1, 0, 0, 0, 0, time.UTC)), ids[2:]},
+		{WithinScope("period_start", "period_end", NewDateTimeRangeUntil(NewDateTime(2018, 8, 20, 12, 0, 0, 0, time.UTC))), ids[:2]},
+	}
+	for _, tt := range tests {
+		var actual []int
+		assert.NoError(t, DB.Model(&DateTimeRangeFieldTestStruct{}).Scopes(tt.scope).Where("id in (?)", ids).Order("id").Pluck("id", &actual).Error)
+		assert.Equal(t, tt.expected, actual)
+	}
+}
+
+func TestDateTimeRangeContains(t *testing.T) {
+	t.Parallel()
+	r := NewDateTimeRange(NewDateTime(2018, 8, 1, 10, 0, 0, 0, time.UTC), NewDateTime(2018, 8, 1, 11, 0, 0, 0, time.UTC))
+	assert.True(t, r.Contains(NewDateTime(2018, 8, 1, 10, 0, 0, 0, time.UTC)))
+	assert.True(t, r.Contains(NewDateTime(2018, 8, 1, 10, 59, 59, 999999999, time.UTC)))
+	assert.False(t, r.Contains(NewDateTime(2018, 8, 1, 11, 0, 0, 0, time.UTC)))
+	assert.False(t, r.Contains(NewDateTime(2018, 8, 1, 9, 59, 59, 0, time.UTC)))
+
+	assert.True(t, DateTimeRange{}.Contains(MinDateTime()))
+	assert.False(t, NewDateTimeRangeUntil(MaxDateTime()).Contains(MaxDateTime()))
+	assert.False(t, NewDateTimeRange(MaxDateTime(), MinDateTime()).Contains(MaxDateTime()))
+}
+
+func TestDateTimeRangeContainsRange(t *testing.T) {
+	t.Parallel()
+	r := NewDateTimeRange(NewDateTime(2018, 8, 1, 10, 0, 0, 0, time.UTC), NewDateTime(2018, 8, 1, 11, 0, 0, 0, time.UTC))
+	assert.True(t, r.ContainsRange(r))
+	assert.True(t, r.ContainsRange(NewDateTimeRange(NewDateTime(2018, 8, 1, 10, 30, 0, 0, time.UTC), NewDateTime(2018, 8, 1, 11, 0, 0, 0, time.UTC))))
+	assert.False(t, r.ContainsRange(NewDateTimeRange(NewDateTime(2018, 8, 1, 10, 30, 0, 0, time.UTC), NewDateTime(2018, 8, 1, 11, 0, 0, 1, time.UTC))))
+	assert.False(t, r.ContainsRange(NewDateTimeRangeFrom(NewDateTime(2018, 8, 1, 10, 30, 0, 0, time.UTC))))
+	assert.True(t, DateTimeRange{}.ContainsRange(r))
+}
+
+func TestDateTimeRangeOverlapsAndIntersect(t *testing.T) {
+	t.Parallel()
+	r := NewDateTimeRange(NewDateTime(2018, 8, 1, 10, 0, 0, 0, time.UTC), NewDateTime(2018, 8, 1, 11, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		u        DateTimeRange
+		expected DateTimeRange
+		overlaps bool
+	}{
+		{NewDateTimeRange(NewDateTime(2018, 8, 1, 11, 0, 0, 0, time.UTC), NewDateTime(2018, 8, 1, 12, 0, 0, 0, time.UTC)), DateTimeRange{Start: r.End, End: r.End}, false},
+		{NewDateTimeRange(NewDateTime(2018, 8, 1, 9, 0, 0, 0, time.UTC), NewDateTime(2018, 8, 1, 10, 0, 0, 1, time.UTC)), NewDateTimeRange(NewDateTime(2018, 8, 1, 10, 0, 0, 0, time.UTC), NewDateTime(2018, 8, 1, 10, 0, 0, 1, time.UTC)), true},
+		{NewDateTimeRangeFrom(NewDateTime(2018, 8, 1, 10, 30, 0, 0, time.UTC)), NewDateTimeRange(NewDateTime(2018, 8, 1, 10, 30, 0, 0, time.UTC), NewDateTime(2018, 8, 1, 11, 0, 0, 0, time.UTC)), true},
+		{DateTimeRange{}, r, true},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.overlaps, r.Overlaps(tt.u))
+		assert.Equal(t, tt.overlaps, tt.u.Overlaps(r))
+		assert.True(t, tt.expected.Equal(r.Intersect(tt.u)))
+		assert.True(t, tt.expected.Equal(tt.u.Intersect(r)))
+	}
+}
+
+func TestDateTimeRangeDuration(t *testing.T) {
+	t.Parallel()
+	d, ok := NewDateTimeRange(NewDateTime(2018, 8, 1, 10, 0, 0, 0, time.UTC), NewDateTime(2018, 8, 1, 11, 30, 0, 0, time.UTC)).Duration()
+	assert.True(t, ok)
+	assert.Equal(t, 90*time.Minute, d)
+
+	d, ok = NewDateTimeRange(NewDateTime(2018, 8, 1, 11, 0, 0, 0, time.UTC), NewDateTime(2018, 8, 1, 10, 0, 0, 0, time.UTC)).Duration()
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), d)
+
+	_, ok = NewDateTimeRangeFrom(NewDateTime(2018, 8, 1, 10, 0, 0, 0, time.UTC)).Duration()
+	assert.False(t, ok)
+}
+
+func TestDateTimeRangeSplit(t *testing.T) {
+	t.Parallel()
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	ist := time.FixedZone("Asia/Kolkata", 5*60*60+30*60)
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+	tests := []struct {
+		r        DateTimeRange
+		bucket   Bucket
+		expected []DateTime
+	}{
+		{
+			NewDateTimeRange(NewDateTime(2018, 8, 1, 10, 15, 0, 0, ist), NewDateTime(2018, 8, 1, 12, 0, 0, 0, ist)),
+			BucketHour,
+			[]DateTime{NewDateTime(2018, 8, 1, 10, 15, 0, 0, ist), NewDateTime(2018, 8, 1, 11, 0, 0, 0, ist), NewDateTime(2018, 8, 1, 12, 0, 0, 0, ist)},
+		},
+		{
+			// DST ends at 2018-11-04 02:00 in New York, and 01:00 to 02:00 is repeated
+			NewDateTimeRange(NewDateTime(2018, 11, 4, 0, 0, 0, 0, newYork), NewDateTime(2018, 11, 4, 3, 0, 0, 0, newYork)),
+			BucketHour,
+			[]DateTime{NewDateTime(2018, 11, 4, 4, 0, 0, 0, time.UTC), NewDateTime(2018, 11, 4, 5, 0, 0, 0, time.UTC), NewDateTime(2018, 11, 4, 6, 0, 0, 0, time.UTC), NewDateTime(2018, 11, 4, 7, 0, 0, 0, time.UTC), NewDateTime(2018, 11, 4, 8, 0, 0, 0, time.UTC)},
+		},
+		{
+			// DST starts at 2018-03-11 02:00 in New York, and 02:00 to 03:00 is skipped
+			NewDateTimeRange(NewDateTime(2018, 3, 11, 0, 0, 0, 0, newYork), NewDateTime(2018, 3, 11, 4, 0, 0, 0, newYork)),
+			BucketHour,
+			[]DateTime{NewDateTime(2018, 3, 11, 5, 0, 0, 0, time.UTC), NewDateTime(2018, 3, 11, 6, 0, 0, 0, time.UTC), NewDateTime(2018, 3, 11, 7, 0, 0, 0, time.UTC), NewDateTime(2018, 3, 11, 8, 0, 0, 0, time.UTC)},
+		},
+		{
+			NewDateTimeRange(NewDateTime(2018, 8, 1, 10, 30, 0, 0, time.UTC), NewDateTime(2018, 8, 1, 12, 15, 0, 0, time.UTC)),
+			BucketHour,
+			[]DateTime{NewDateTime(2018, 8, 1, 10, 30, 0, 0, time.UTC), NewDateTime(2018, 8, 1, 11, 0, 0, 0, time.UTC), NewDateTime(2018, 8, 1, 12, 0, 0, 0, time.UTC), NewDateTime(2018, 8, 1, 12, 15, 0, 0, time.UTC)},
+		},
+		{
+			NewDateTimeRange(NewDateTime(2018, 8, 1, 10, 0, 0, 0, jst), NewDateTime(2018, 8, 3, 0, 0, 0, 0, jst)),
+			BucketDay,
+			[]DateTime{NewDateTime(2018, 8, 1, 10, 0, 0, 0, jst), NewDateTime(2018, 8, 2, 0, 0, 0, 0, jst), NewDateTime(2018, 8, 3, 0, 0, 0, 0, jst)},
+		},
+		{
+			// 2018-08-01 is Wednesday, and 2018-08-06 is Monday
+			NewDateTimeRange(NewDateTime(2018, 8, 1, 0, 0, 0, 0, time.UTC), NewDateTime(2018, 8, 14, 0, 0, 0, 0, time.UTC)),
+			BucketWeek,
+			[]DateTime{NewDateTime(2018, 8, 1, 0, 0, 0, 0, time.UTC), NewDateTime(2018, 8, 6, 0, 0, 0, 0, time.UTC), NewDateTime(2018, 8, 13, 0, 0, 0, 0, time.UTC), NewDateTime(2018, 8, 14, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			NewDateTimeRange(NewDateTime(2018, 8, 6, 0, 0, 0, 0, time.UTC), NewDateTime(2018, 8, 6, 0, 0, 0, 0, time.UTC)),
+			BucketWeek,
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		actual, err := tt.r.Split(tt.bucket)
+		assert.NoError(t, err)
+		var expected []DateTimeRange
+		for i := 1; i < len(tt.expected); i++ {
+			expected = append(expected, NewDateTimeRange(tt.expected[i-1], tt.expected[i]))
+		}
+		if assert.Len(t, actual, len(expected)) {
+			for i := range expected {
+				assert.True(t, expected[i].Equal(actual[i]), actual[i])
+			}
+		}
+	}
+
+	_, err = NewDateTimeRangeFrom(NowDateTime()).Split(BucketDay)
+	assert.Equal(t, ErrUnboundedRange, err)
+}
+
+func TestDateTimeRangeCondition(t *testing.T) {
+	t.Parallel()
+	quote := func(s string) string { return "`" + s + "`" }
+	start := NewDateTime(2018, 8, 1, 10, 0, 0, 0, time.UTC)
+	end := NewDateTime(2018, 8, 1, 11, 0, 0, 0, time.UTC)
+
+	query, args := overlapsCondition(quote, "starts_at", "ends_at", NewDateTimeRange(start, end))
+	assert.Equal(t, "(`starts_at` IS NULL OR `starts_at` < ?) AND (`ends_at` IS NULL OR `ends_at` > ?)", query)
+	assert.Equal(t, []interface{}{end, start}, args)
+
+	query, args = overlapsCondition(quote, "starts_at", "ends_at", NewDateTimeRangeFrom(start))
+	assert.Equal(t, "(`ends_at` IS NULL OR `ends_at` > ?)", query)
+	assert.Equal(t, []interface{}{start}, args)
+
+	query, args = overlapsCondition(quote, "starts_at", "ends_at", DateTimeRange{})
+	assert.Equal(t, "1 = 1", query)
+	assert.Empty(t, args)
+
+	query, args = overlapsCondition(quote, "starts_at", "ends_at", NewDateTimeRange(end, start))
+	assert.Equal(t, "1 = 0", query)
+	assert.Empty(t, args)
+
+	query, args = containsCondition(quote, "starts_at", "ends_at", start)
+	assert.Equal(t, "(`starts_at` IS NULL OR `starts_at` <= ?) AND (`ends_at` IS NULL OR `ends_at` > ?)", query)
+	assert.Equal(t, []interface{}{start, start}, args)
+
+	query, args = withinCondition(quote, "starts_at", "ends_at", NewDateTimeRange(start, end))
+	assert.Equal(t, "`starts_at` >= ? AND `ends_at` <= ?", query)
+	assert.Equal(t, []interface{}{start, end}, args)
+}
+
+func TestDateTimeRangeMarshalJSON(t *testing.T) {
+	t.Parallel()
+	src := NewDateTimeRangeFrom(NewDateTime(2018, 8, 1, 10, 0, 0, 0, time.UTC))
+	data, err := json.Marshal(src)
+	assert.NoError(t, err)
+
+	dst := DateTimeRange{}
+	assert.NoError(t, json.Unmarshal(data, &dst))
+	assert.True(t, src.Equal(dst))
+}
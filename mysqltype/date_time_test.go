@@ -1,7 +1,10 @@
 package mysqltype
 
 import (
+	"bytes"
+	"encoding/gob"
 	"encoding/json"
+	"encoding/xml"
 	"testing"
 	"time"
 
@@ -36,7 +39,8 @@ func TestDateTimeField(t *testing.T) {
 	assert.NoError(t, DB.First(dst).Error)
 	assertTimeEquals(t, target.TargetDate.src, dst.TargetDate.src)
 
-	assert.NoError(t, DB.Save(&DateTimeFieldTestStruct{TargetDate: MaxDateTime()}).Error)
+	// the column has no fractional seconds, and MySQL would round MaxDateTime up out of range
+	assert.NoError(t, DB.Save(&DateTimeFieldTestStruct{TargetDate: MaxDateTime().Truncate(time.Second)}).Error)
 	assert.NoError(t, DB.Save(&DateTimeFieldTestStruct{TargetDate: MinDateTime()}).Error)
 }
 
@@ -69,12 +73,103 @@ func TestDateTimeMarshalJSON(t *testing.T) {
 	assert.EqualValues(t, "\""+string(expected)+"\"", string(actual))
 }
 
+func TestDateTimeGobEncode(t *testing.T) {
+	t.Parallel()
+	for _, v := range []DateTime{NewDateTime(2018, 8, 20, 10, 20, 30, 123456789, time.UTC), MySQLZeroDateTime()} {
+		buf := &bytes.Buffer{}
+		assert.NoError(t, gob.NewEncoder(buf).Encode(v))
+		dst := DateTime{}
+		assert.NoError(t, gob.NewDecoder(buf).Decode(&dst))
+		assert.True(t, v.Equal(dst))
+	}
+}
+
+func TestDateTimeMarshalXML(t *testing.T) {
+	t.Parallel()
+	type target struct {
+		XMLName xml.Name `xml:"target"`
+		Attr    DateTime `xml:"attr,attr"`
+		Elem    DateTime `xml:"elem"`
+	}
+	v := target{Attr: NewDateTime(2018, 8, 20, 10, 20, 30, 123000000, time.UTC), Elem: MySQLZeroDateTime()}
+	actual, err := xml.Marshal(v)
+	assert.NoError(t, err)
+	assert.Equal(t, `<target attr="2018-08-20T10:20:30.123Z"><elem>0000-00-00 00:00:00</elem></target>`, string(actual))
+	dst := target{}
+	assert.NoError(t, xml.Unmarshal(actual, &dst))
+	assert.Equal(t, v.Attr, dst.Attr)
+	assert.Equal(t, v.Elem, dst.Elem)
+
+	assert.NoError(t, xml.Unmarshal([]byte(`<target attr="2018-08-20 10:20:30"></target>`), &dst))
+	assert.Equal(t, NewDateTime(2018, 8, 20, 10, 20, 30, 0, location), dst.Attr)
+	assertOutOfRange(t, xml.Unmarshal([]byte(`<target><elem>0999-12-31T00:00:00Z</elem></target>`), &dst))
+}
+
+func TestDateTimeMarshalYAML(t *testing.T) {
+	t.Parallel()
+	actual, err := NewDateTime(2018, 8, 20, 10, 20, 30, 0, time.UTC).MarshalYAML()
+	assert.NoError(t, err)
+	assert.Equal(t, "2018-08-20T10:20:30Z", actual)
+
+	dst := DateTime{}
+	assert.NoError(t, dst.UnmarshalYAML(func(v interface{}) error {
+		*v.(*string) = "0000-00-00 00:00:00"
+		return nil
+	}))
+	assert.True(t, dst.IsMySQLZero())
+}
+
+func TestDateTimeMarshalTOML(t *testing.T) {
+	t.Parallel()
+	actual, err := NewDateTime(2018, 8, 20, 10, 20, 30, 500000000, time.UTC).MarshalTOML()
+	assert.NoError(t, err)
+	assert.Equal(t, "2018-08-20T10:20:30.5Z", string(actual))
+	actual, err = MySQLZeroDateTime().MarshalTOML()
+	assert.NoError(t, err)
+	assert.Equal(t, `"0000-00-00 00:00:00"`, string(actual))
+
+	dst := DateTime{}
+	jst := time.FixedZone("JST", 9*60*60)
+	assert.NoError(t, dst.UnmarshalTOML(time.Date(2018, 8, 20, 10, 20, 30, 0, jst)))
+	assert.Equal(t, NewDateTime(2018, 8, 20, 10, 20, 30, 0, jst), dst)
+	assert.NoError(t, dst.UnmarshalTOML(time.Date(2018, 8, 20, 10, 20, 30, 0, time.FixedZone("datetime-local", 0))))
+	assert.Equal(t, NewDateTime(2018, 8, 20, 10, 20, 30, 0, location), dst)
+	assertInvalidValueType(t, dst.UnmarshalTOML(true))
+}
+
 func TestDateTimeValue(t *testing.T) {
 	t.Parallel()
 	dateTime := NowDateTime()
 	v, err := dateTime.Value()
 	assert.NoError(t, err)
-	assert.Equal(t, dateTime.src, v)
+	assert.Equal(t, dateTime.src.In(time.UTC), v)
+
+	_, err = MinDateTime().Add(-time.Nanosecond).Value()
+	assertOutOfRange(t, err)
+	_, err = MaxDateTime().Add(time.Microsecond).Value()
+	assertOutOfRange(t, err)
+}
+
+func TestNewDateTimeChecked(t *testing.T) {
+	t.Parallel()
+	v, err := NewDateTimeChecked(9999, 12, 31, 23, 59, 59, 999999000, time.UTC)
+	assert.NoError(t, err)
+	assert.Equal(t, MaxDateTime(), v)
+	_, err = NewDateTimeChecked(10000, 1, 1, 0, 0, 0, 0, time.UTC)
+	assertOutOfRange(t, err)
+	_, err = NewDateTimeFromTimeChecked(time.Time{})
+	assertOutOfRange(t, err)
+}
+
+func TestDateTimeUnmarshalOutOfRange(t *testing.T) {
+	t.Parallel()
+	target := MinDateTime()
+	assertOutOfRange(t, target.UnmarshalText([]byte("0001-01-01T00:00:00Z")))
+	assertOutOfRange(t, json.Unmarshal([]byte(`"0999-12-31T00:00:00Z"`), &target))
+	data, err := time.Time{}.MarshalBinary()
+	assert.NoError(t, err)
+	assertOutOfRange(t, target.UnmarshalBinary(data))
+	assert.Equal(t, MinDateTime(), target)
 }
 
 func TestDateTimeScan(t *testing.T) {
@@ -91,6 +186,35 @@ func TestDateTimeScan(t *testing.T) {
 	assertTimeEquals(t, nowFromFormat, target2.src)
 }
 
+func TestDateTimeScanVariousValues(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		value    interface{}
+		expected DateTime
+	}{
+		{"2018-08-20 10:20:30", NewDateTime(2018, 8, 20, 10, 20, 30, 0, time.UTC)},
+		{[]byte("2018-08-20 10:20:30.123456"), NewDateTime(2018, 8, 20, 10, 20, 30, 123456000, time.UTC)},
+		{"2018-08-20T10:20:30", NewDateTime(2018, 8, 20, 10, 20, 30, 0, time.UTC)},
+		{"2018-08-20T19:20:30+09:00", NewDateTime(2018, 8, 20, 10, 20, 30, 0, time.UTC)},
+		{"2018-08-20 10:20", NewDateTime(2018, 8, 20, 10, 20, 0, 0, time.UTC)},
+		{"20180820102030", NewDateTime(2018, 8, 20, 10, 20, 30, 0, time.UTC)},
+		{"2018-08-20", NewDateTime(2018, 8, 20, 0, 0, 0, 0, time.UTC)},
+		{`"2018-08-20 10:20:30.5"`, NewDateTime(2018, 8, 20, 10, 20, 30, 500000000, time.UTC)},
+		{int64(1534760430), NewDateTime(2018, 8, 20, 10, 20, 30, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		target := DateTime{}
+		assert.NoError(t, target.Scan(tt.value), "value: %v", tt.value)
+		assertTimeEquals(t, tt.expected.src, target.src)
+	}
+
+	target := DateTime{}
+	assert.Error(t, target.Scan("10:20:30"))
+	err := target.Scan(true)
+	assertInvalidValueType(t, err)
+	assert.Contains(t, err.Error(), "bool")
+}
+
 func TestDateTimeAfterAndBefore(t *testing.T) {
 	t.Parallel()
 	v1 := NewDateTime(2008, 10, 12, 2, 30, 2, 0, time.UTC)
@@ -313,5 +437,5 @@ func TestDateTimeNanosecond(t *testing.T) {
 	expected := max.src.Nanosecond()
 	actual := max.Nanosecond()
 	assert.Equal(t, expected, actual)
-	assert.Equal(t, 999999, actual)
+	assert.Equal(t, 999999000, actual)
 }
@@ -0,0 +1,497 @@
+package mysqltype
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+)
+
+// Decimal support MySQL DECIMAL type as exact decimal number
+// https://dev.mysql.com/doc/refman/8.0/en/fixed-point-types.html
+// It is unscaled * 10^-scale with unscaled of arbitrary precision, and keeps the scale as MySQL does,
+// e.g. "1.50" has scale 2 and equals "1.5". The zero value is 0.
+// Columns are decimal(65,30) unless precision and scale are declared by `gorm:"type:decimal(p,s)"`.
+type Decimal struct {
+	unscaled *big.Int `gorm:"type:decimal(65,30)"` // nil means 0, never modified after creation
+	scale    int32
+}
+
+const (
+	// maxDecimalPrecision maximum number of digits of MySQL DECIMAL
+	maxDecimalPrecision = 65
+	// maxDecimalScale maximum number of digits after the decimal point of MySQL DECIMAL
+	maxDecimalScale = 30
+	// maxDecimalExponent maximum absolute exponent accepted by NewDecimalFromString
+	maxDecimalExponent = 1000
+)
+
+// RoundingMode how to round Decimal to fewer digits
+type RoundingMode int
+
+const (
+	// RoundHalfUp round half away from zero as MySQL does
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven round half to even, known as banker's rounding
+	RoundHalfEven
+	// RoundHalfDown round half toward zero
+	RoundHalfDown
+	// RoundUp round away from zero
+	RoundUp
+	// RoundDown round toward zero, i.e. truncate
+	RoundDown
+	// RoundCeiling round toward positive infinity
+	RoundCeiling
+	// RoundFloor round toward negative infinity
+	RoundFloor
+)
+
+// DecimalJSONMode how to marshal Decimal to JSON
+type DecimalJSONMode int
+
+const (
+	// DecimalJSONString marshal as JSON string, so that JavaScript does not lose precision
+	DecimalJSONString DecimalJSONMode = iota
+	// DecimalJSONNumber marshal as JSON number
+	DecimalJSONNumber
+)
+
+var decimalJSONMode = DecimalJSONString
+
+// SetDecimalJSONMode set how Decimal and NullDecimal are marshalled to JSON, DecimalJSONString by default
+// UnmarshalJSON accepts both regardless of the mode.
+// It is not safe to call concurrently with marshalling, so call it on initialization.
+func SetDecimalJSONMode(mode DecimalJSONMode) {
+	decimalJSONMode = mode
+}
+
+// NewDecimal Create new Decimal of unscaled * 10^-scale
+func NewDecimal(unscaled int64, scale int32) Decimal {
+	return newDecimal(big.NewInt(unscaled), scale)
+}
+
+// NewDecimalFromInt Create new Decimal of integer
+func NewDecimalFromInt(v int64) Decimal {
+	return NewDecimal(v, 0)
+}
+
+// NewDecimalFromBigInt Create new Decimal of unscaled * 10^-scale
+// unscaled is copied.
+func NewDecimalFromBigInt(unscaled *big.Int, scale int32) Decimal {
+	return newDecimal(new(big.Int).Set(unscaled), scale)
+}
+
+// NewDecimalFromString Create new Decimal from decimal text such as "-12.30" or "1.5e-3"
+// The scale is kept as written, and it returns ErrInvalidFormat for other text.
+func NewDecimalFromString(s string) (Decimal, error) {
+	mantissa, exponent := s, 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.Atoi(strings.TrimPrefix(s[i+1:], "+"))
+		if err != nil || e < -maxDecimalExponent || maxDecimalExponent < e || s[i+1:] == "" {
+			return Decimal{}, ErrInvalidFormat
+		}
+		mantissa, exponent = s[:i], e
+	}
+	sign := ""
+	if mantissa != "" && (mantissa[0] == '-' || mantissa[0] == '+') {
+		sign, mantissa = mantissa[:1], mantissa[1:]
+	}
+	intPart, fracPart := mantissa, ""
+	if i := strings.IndexByte(mantissa, '.'); i >= 0 {
+		intPart, fracPart = mantissa[:i], mantissa[i+1:]
+	}
+	if (intPart != "" && !isDigits(intPart)) || (fracPart != "" && !isDigits(fracPart)) || intPart+fracPart == "" {
+		return Decimal{}, ErrInvalidFormat
+	}
+	unscaled, ok := new(big.Int).SetString(sign+intPart+fracPart, 10)
+	if !ok {
+		return Decimal{}, ErrInvalidFormat
+	}
+	return newDecimal(unscaled, int32(len(fracPart)-exponent)), nil
+}
+
+// NewDecimalFromFloat Create new Decimal from the shortest decimal text representing f
+// It returns ErrOutOfRange for NaN and infinity.
+func NewDecimalFromFloat(f float64) (Decimal, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Decimal{}, ErrOutOfRange
+	}
+	return NewDecimalFromString(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
+// newDecimal Decimal taking unscaled, with negative scale normalized to 0
+func newDecimal(unscaled *big.Int, scale int32) Decimal {
+	if scale < 0 {
+		unscaled.Mul(unscaled, pow10(int(-scale)))
+		scale = 0
+	}
+	return Decimal{unscaled: unscaled, scale: scale}
+}
+
+// Unscaled unscaled integer of d
+func (d Decimal) Unscaled() *big.Int {
+	return new(big.Int).Set(d.int())
+}
+
+// Scale number of digits after the decimal point
+func (d Decimal) Scale() int {
+	return int(d.scale)
+}
+
+// Sign -1 if d < 0, 0 if d == 0 and +1 if d > 0
+func (d Decimal) Sign() int {
+	return d.int().Sign()
+}
+
+// IsZero reports whether d is 0 in any scale
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Cmp -1 if d < u, 0 if d == u and +1 if d > u
+func (d Decimal) Cmp(u Decimal) int {
+	a, b := align(d, u)
+	return a.Cmp(b)
+}
+
+// Equal reports whether d and u are same number regardless of the scale
+func (d Decimal) Equal(u Decimal) bool {
+	return d.Cmp(u) == 0
+}
+
+// Neg returns -d
+func (d Decimal) Neg() Decimal {
+	return Decimal{unscaled: new(big.Int).Neg(d.int()), scale: d.scale}
+}
+
+// Abs returns |d|
+func (d Decimal) Abs() Decimal {
+	return Decimal{unscaled: new(big.Int).Abs(d.int()), scale: d.scale}
+}
+
+// Add returns d + u in the larger scale
+func (d Decimal) Add(u Decimal) Decimal {
+	a, b := align(d, u)
+	return Decimal{unscaled: a.Add(a, b), scale: maxScale(d, u)}
+}
+
+// Sub returns d - u in the larger scale
+func (d Decimal) Sub(u Decimal) Decimal {
+	a, b := align(d, u)
+	return Decimal{unscaled: a.Sub(a, b), scale: maxScale(d, u)}
+}
+
+// Mul returns d * u in the sum of scales
+func (d Decimal) Mul(u Decimal) Decimal {
+	return Decimal{unscaled: new(big.Int).Mul(d.int(), u.int()), scale: d.scale + u.scale}
+}
+
+// Div returns d / u rounded to scale digits after the decimal point by mode
+// It returns ErrDivisionByZero if u is 0.
+func (d Decimal) Div(u Decimal, scale int, mode RoundingMode) (Decimal, error) {
+	if u.IsZero() {
+		return Decimal{}, ErrDivisionByZero
+	}
+	if scale < 0 {
+		scale = 0
+	}
+	num, den := new(big.Int).Set(d.int()), new(big.Int).Set(u.int())
+	if e := scale + int(u.scale) - int(d.scale); e >= 0 {
+		num.Mul(num, pow10(e))
+	} else {
+		den.Mul(den, pow10(-e))
+	}
+	return Decimal{unscaled: roundQuo(num, den, mode), scale: int32(scale)}, nil
+}
+
+// Round returns d rounded to scale digits after the decimal point by mode
+// If d has fewer digits, trailing zeros are added.
+func (d Decimal) Round(scale int, mode RoundingMode) Decimal {
+	if scale < 0 {
+		scale = 0
+	}
+	if scale >= int(d.scale) {
+		return Decimal{unscaled: new(big.Int).Mul(d.int(), pow10(scale-int(d.scale))), scale: int32(scale)}
+	}
+	return Decimal{unscaled: roundQuo(d.int(), pow10(int(d.scale)-scale), mode), scale: int32(scale)}
+}
+
+// Truncate returns d truncated to scale digits after the decimal point
+func (d Decimal) Truncate(scale int) Decimal {
+	return d.Round(scale, RoundDown)
+}
+
+// Float64 nearest float64 of d
+func (d Decimal) Float64() float64 {
+	f, _ := new(big.Rat).SetFrac(d.int(), pow10(int(d.scale))).Float64()
+	return f
+}
+
+// Int64 integer part of d
+// It reports false if the integer part overflows int64.
+func (d Decimal) Int64() (int64, bool) {
+	v := d.Truncate(0).int()
+	return v.Int64(), v.IsInt64()
+}
+
+// String format as MySQL DECIMAL literal with all digits of the scale, e.g. "-0.50"
+func (d Decimal) String() string {
+	digits := new(big.Int).Abs(d.int()).String()
+	if d.scale > 0 {
+		if pad := int(d.scale) + 1 - len(digits); pad > 0 {
+			digits = strings.Repeat("0", pad) + digits
+		}
+		digits = digits[:len(digits)-int(d.scale)] + "." + digits[len(digits)-int(d.scale):]
+	}
+	if d.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// Validate reports OutOfRangeError if d does not fit MySQL DECIMAL(precision, scale)
+// Unlike MySQL, digits exceeding the scale are not rounded but reported, so Round d explicitly.
+// It returns ErrInvalidFormat if precision and scale are not valid for MySQL.
+func (d Decimal) Validate(precision, scale int) error {
+	if precision < 1 || maxDecimalPrecision < precision || scale < 0 || maxDecimalScale < scale || precision < scale {
+		return ErrInvalidFormat
+	}
+	max := Decimal{unscaled: new(big.Int).Sub(pow10(precision), big.NewInt(1)), scale: int32(scale)}
+	if d.Truncate(scale).Cmp(d) != 0 || d.Abs().Cmp(max) > 0 {
+		return &OutOfRangeError{Value: d, Min: max.Neg(), Max: max}
+	}
+	return nil
+}
+
+// UnmarshalText parse decimal text as NewDecimalFromString
+func (d *Decimal) UnmarshalText(text []byte) error {
+	v, err := NewDecimalFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// MarshalText format as String
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalJSON parse JSON string or number
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+// MarshalJSON format as JSON string or number according to SetDecimalJSONMode
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	if decimalJSONMode == DecimalJSONNumber {
+		return []byte(d.String()), nil
+	}
+	return []byte(`"` + d.String() + `"`), nil
+}
+
+// GobEncode encode as MarshalText
+func (d Decimal) GobEncode() ([]byte, error) {
+	return d.MarshalText()
+}
+
+// GobDecode decode as UnmarshalText
+func (d *Decimal) GobDecode(data []byte) error {
+	return d.UnmarshalText(data)
+}
+
+var _ driver.Valuer = Decimal{}
+var _ sql.Scanner = &Decimal{}
+var _ encoding.TextUnmarshaler = &Decimal{}
+var _ encoding.TextMarshaler = Decimal{}
+var _ json.Marshaler = Decimal{}
+var _ json.Unmarshaler = &Decimal{}
+var _ gob.GobEncoder = Decimal{}
+var _ gob.GobDecoder = &Decimal{}
+
+// Scan for sql.Scanner
+func (d *Decimal) Scan(value interface{}) error {
+	var v Decimal
+	var err error
+	switch src := value.(type) {
+	case []byte:
+		v, err = NewDecimalFromString(string(src))
+	case string:
+		v, err = NewDecimalFromString(src)
+	case float64:
+		v, err = NewDecimalFromFloat(src)
+	case int64:
+		v = NewDecimalFromInt(src)
+	default:
+		return &InvalidValueTypeError{Value: value, Target: "Decimal"}
+	}
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// Value for driver.Valuer
+// It returns String, so that the driver does not convert it to float.
+func (d Decimal) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// RegisterDecimalCallbacks validate Decimal and NullDecimal fields by Validate before gorm creates and updates rows
+// The precision and scale are taken from `gorm:"type:decimal(p,s)"` of the field,
+// and fields without it are not validated, so that MySQL rounds values into the default decimal(65,30) columns.
+// The error is OutOfRangeError, and rows are not saved.
+func RegisterDecimalCallbacks(db *gorm.DB) {
+	db.Callback().Create().Before("gorm:create").Register("mysqltype:validate_decimal", validateDecimalCallback)
+	db.Callback().Update().Before("gorm:update").Register("mysqltype:validate_decimal", validateDecimalCallback)
+}
+
+func validateDecimalCallback(scope *gorm.Scope) {
+	if scope.HasError() {
+		return
+	}
+	for _, field := range scope.Fields() {
+		var d Decimal
+		switch v := field.Field.Interface().(type) {
+		case Decimal:
+			d = v
+		case *Decimal:
+			if v == nil {
+				continue
+			}
+			d = *v
+		case NullDecimal:
+			if !v.Valid {
+				continue
+			}
+			d = v.Decimal
+		default:
+			continue
+		}
+		precision, scale, ok := parseDecimalType(tagType(field.Struct.Tag))
+		if !ok {
+			continue
+		}
+		if err := d.Validate(precision, scale); err != nil {
+			scope.Err(err)
+			return
+		}
+	}
+}
+
+// tagType type declared by the sql or gorm tag
+// It reads the tag of the field itself, since TagSettings also has the type merged from the tag of Decimal.unscaled,
+// which is only the default of columns.
+func tagType(tag reflect.StructTag) string {
+	for _, key := range []string{"sql", "gorm"} {
+		for _, setting := range strings.Split(tag.Get(key), ";") {
+			kv := strings.SplitN(setting, ":", 2)
+			if len(kv) == 2 && strings.ToUpper(strings.TrimSpace(kv[0])) == "TYPE" {
+				return strings.TrimSpace(kv[1])
+			}
+		}
+	}
+	return ""
+}
+
+// parseDecimalType precision and scale of "decimal(p,s)", "decimal(p)" or "numeric(p,s)"
+func parseDecimalType(typ string) (precision, scale int, ok bool) {
+	typ = strings.ToLower(strings.Replace(typ, " ", "", -1))
+	for _, prefix := range []string{"decimal(", "numeric(", "dec(", "fixed("} {
+		if strings.HasPrefix(typ, prefix) {
+			typ = typ[len(prefix):]
+			end := strings.IndexByte(typ, ')')
+			if end < 0 {
+				return 0, 0, false
+			}
+			args := strings.Split(typ[:end], ",")
+			var err error
+			if precision, err = strconv.Atoi(args[0]); err != nil {
+				return 0, 0, false
+			}
+			if len(args) == 2 {
+				if scale, err = strconv.Atoi(args[1]); err != nil {
+					return 0, 0, false
+				}
+			}
+			return precision, scale, len(args) <= 2
+		}
+	}
+	return 0, 0, false
+}
+
+// int unscaled integer of d, which must not be modified
+func (d Decimal) int() *big.Int {
+	if d.unscaled == nil {
+		return new(big.Int)
+	}
+	return d.unscaled
+}
+
+// align unscaled integers of d and u in the larger scale
+func align(d, u Decimal) (*big.Int, *big.Int) {
+	scale := maxScale(d, u)
+	a := new(big.Int).Mul(d.int(), pow10(int(scale-d.scale)))
+	b := new(big.Int).Mul(u.int(), pow10(int(scale-u.scale)))
+	return a, b
+}
+
+func maxScale(d, u Decimal) int32 {
+	if d.scale > u.scale {
+		return d.scale
+	}
+	return u.scale
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// roundQuo num / den rounded by mode
+func roundQuo(num, den *big.Int, mode RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+	sign := num.Sign() * den.Sign()
+	twice := new(big.Int).Abs(r)
+	half := twice.Lsh(twice, 1).CmpAbs(den)
+	var away bool
+	switch mode {
+	case RoundHalfUp:
+		away = half >= 0
+	case RoundHalfEven:
+		away = half > 0 || (half == 0 && q.Bit(0) == 1)
+	case RoundHalfDown:
+		away = half > 0
+	case RoundUp:
+		away = true
+	case RoundDown:
+		away = false
+	case RoundCeiling:
+		away = sign > 0
+	case RoundFloor:
+		away = sign < 0
+	}
+	if away {
+		q.Add(q, big.NewInt(int64(sign)))
+	}
+	return q
+}
@@ -0,0 +1,246 @@
+package mysqltype
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type DecimalFieldTestStruct struct {
+	ID       int
+	Price    Decimal     `gorm:"type:decimal(10,2);not null"`
+	Discount NullDecimal `gorm:"type:decimal(5,4)"`
+	Huge     Decimal
+}
+
+func TestDecimalField(t *testing.T) {
+	t.Parallel()
+	RegisterDecimalCallbacks(DB)
+	assert.NoError(t, DB.AutoMigrate(&DecimalFieldTestStruct{}).Error)
+
+	target := &DecimalFieldTestStruct{
+		Price:    mustDecimal(t, "12345678.90"),
+		Discount: NewNullDecimal(mustDecimal(t, "0.125")),
+		Huge:     mustDecimal(t, "12345678901234567890123456789012345.123456789012345678901234567890"),
+	}
+	assert.NoError(t, DB.Create(target).Error)
+	dst := &DecimalFieldTestStruct{ID: target.ID}
+	assert.NoError(t, DB.First(dst).Error)
+	assert.Equal(t, "12345678.90", dst.Price.String())
+	assert.Equal(t, "0.1250", dst.Discount.Decimal.String())
+	assert.True(t, target.Huge.Equal(dst.Huge))
+
+	assertOutOfRange(t, DB.Create(&DecimalFieldTestStruct{Price: mustDecimal(t, "123456789.00")}).Error)
+	assertOutOfRange(t, DB.Create(&DecimalFieldTestStruct{Price: mustDecimal(t, "0.001")}).Error)
+	assertOutOfRange(t, DB.Save(&DecimalFieldTestStruct{ID: target.ID, Discount: NewNullDecimal(NewDecimalFromInt(10))}).Error)
+	// fields without type tags are not validated, and MySQL rounds them
+	assert.NoError(t, DB.Create(&DecimalFieldTestStruct{Huge: mustDecimal(t, "0.0000000000000000000000000000001")}).Error)
+}
+
+func TestNewDecimalFromString(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		text     string
+		expected string
+		scale    int
+	}{
+		{"0", "0", 0},
+		{"-12.30", "-12.30", 2},
+		{"+1.5", "1.5", 1},
+		{".5", "0.5", 1},
+		{"5.", "5", 0},
+		{"1.5e-3", "0.0015", 4},
+		{"1.5E+3", "1500", 0},
+		{"-0.000", "0.000", 3},
+		{"123456789012345678901234567890.123456789", "123456789012345678901234567890.123456789", 9},
+	} {
+		actual, err := NewDecimalFromString(tt.text)
+		assert.NoError(t, err, tt.text)
+		assert.Equal(t, tt.expected, actual.String(), tt.text)
+		assert.Equal(t, tt.scale, actual.Scale(), tt.text)
+	}
+	for _, text := range []string{"", "-", ".", "1.2.3", "1e", "e3", "1,000", " 1", "0x10", "1e1001", "NaN"} {
+		_, err := NewDecimalFromString(text)
+		assert.Equal(t, ErrInvalidFormat, err, text)
+	}
+}
+
+func TestNewDecimalFromFloat(t *testing.T) {
+	t.Parallel()
+	v, err := NewDecimalFromFloat(0.1)
+	assert.NoError(t, err)
+	assert.Equal(t, "0.1", v.String())
+	v, err = NewDecimalFromFloat(-1e-7)
+	assert.NoError(t, err)
+	assert.Equal(t, "-0.0000001", v.String())
+
+	_, err = NewDecimalFromFloat(math.NaN())
+	assert.Equal(t, ErrOutOfRange, err)
+	_, err = NewDecimalFromFloat(math.Inf(-1))
+	assert.Equal(t, ErrOutOfRange, err)
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	t.Parallel()
+	a := mustDecimal(t, "10.25")
+	b := mustDecimal(t, "-0.1")
+
+	assert.Equal(t, "10.15", a.Add(b).String())
+	assert.Equal(t, "10.35", a.Sub(b).String())
+	assert.Equal(t, "-1.025", a.Mul(b).String())
+	assert.Equal(t, "0.1", b.Neg().String())
+	assert.Equal(t, "0.1", b.Abs().String())
+	assert.Equal(t, "0.30", mustDecimal(t, "0.1").Add(mustDecimal(t, "0.20")).String())
+	assert.Equal(t, "0", Decimal{}.String())
+	assert.Equal(t, "1.5", Decimal{}.Add(mustDecimal(t, "1.5")).String())
+
+	v, err := a.Div(NewDecimalFromInt(3), 4, RoundHalfUp)
+	assert.NoError(t, err)
+	assert.Equal(t, "3.4167", v.String())
+	v, err = NewDecimalFromInt(1).Div(mustDecimal(t, "0.03"), 0, RoundDown)
+	assert.NoError(t, err)
+	assert.Equal(t, "33", v.String())
+	_, err = a.Div(mustDecimal(t, "0.00"), 2, RoundHalfUp)
+	assert.Equal(t, ErrDivisionByZero, err)
+}
+
+func TestDecimalRound(t *testing.T) {
+	t.Parallel()
+	modes := []RoundingMode{RoundHalfUp, RoundHalfEven, RoundHalfDown, RoundUp, RoundDown, RoundCeiling, RoundFloor}
+	for _, tt := range []struct {
+		text     string
+		expected []string
+	}{
+		{"2.5", []string{"3", "2", "2", "3", "2", "3", "2"}},
+		{"3.5", []string{"4", "4", "3", "4", "3", "4", "3"}},
+		{"-2.5", []string{"-3", "-2", "-2", "-3", "-2", "-2", "-3"}},
+		{"2.51", []string{"3", "3", "3", "3", "2", "3", "2"}},
+		{"-2.49", []string{"-2", "-2", "-2", "-3", "-2", "-2", "-3"}},
+		{"2", []string{"2", "2", "2", "2", "2", "2", "2"}},
+	} {
+		for i, mode := range modes {
+			assert.Equal(t, tt.expected[i], mustDecimal(t, tt.text).Round(0, mode).String(), "%s %d", tt.text, mode)
+		}
+	}
+	assert.Equal(t, "1.2300", mustDecimal(t, "1.23").Round(4, RoundDown).String())
+	assert.Equal(t, "0.00", mustDecimal(t, "-0.004").Round(2, RoundHalfUp).String())
+	assert.Equal(t, "-1.99", mustDecimal(t, "-1.999").Truncate(2).String())
+}
+
+func TestDecimalCmp(t *testing.T) {
+	t.Parallel()
+	assert.True(t, mustDecimal(t, "1.50").Equal(mustDecimal(t, "1.5")))
+	assert.Equal(t, -1, mustDecimal(t, "-2").Cmp(mustDecimal(t, "1.5")))
+	assert.Equal(t, 1, mustDecimal(t, "0.01").Cmp(Decimal{}))
+	assert.True(t, mustDecimal(t, "0.000").IsZero())
+	assert.Equal(t, -1, mustDecimal(t, "-0.1").Sign())
+}
+
+func TestDecimalConversion(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, 1.25, mustDecimal(t, "1.25").Float64())
+	v, ok := mustDecimal(t, "-12.9").Int64()
+	assert.True(t, ok)
+	assert.Equal(t, int64(-12), v)
+	_, ok = mustDecimal(t, "1e20").Int64()
+	assert.False(t, ok)
+
+	unscaled := big.NewInt(12345)
+	d := NewDecimalFromBigInt(unscaled, 2)
+	unscaled.SetInt64(0)
+	assert.Equal(t, "123.45", d.String())
+	assert.Equal(t, big.NewInt(12345), d.Unscaled())
+	assert.Equal(t, "12300", NewDecimal(123, -2).String())
+}
+
+func TestDecimalValidate(t *testing.T) {
+	t.Parallel()
+	assert.NoError(t, mustDecimal(t, "99999999.99").Validate(10, 2))
+	assert.NoError(t, mustDecimal(t, "-99999999.99").Validate(10, 2))
+	assert.NoError(t, mustDecimal(t, "1.5000").Validate(10, 2))
+	assertOutOfRange(t, mustDecimal(t, "100000000").Validate(10, 2))
+	assertOutOfRange(t, mustDecimal(t, "0.001").Validate(10, 2))
+	assertOutOfRange(t, mustDecimal(t, "0.5").Validate(1, 0))
+	assert.Equal(t, ErrInvalidFormat, Decimal{}.Validate(66, 0))
+	assert.Equal(t, ErrInvalidFormat, Decimal{}.Validate(5, 6))
+
+	err := mustDecimal(t, "1000").Validate(5, 2)
+	assert.EqualError(t, err, "out of range: 1000 is not in [-999.99, 999.99]")
+}
+
+func TestDecimalScan(t *testing.T) {
+	t.Parallel()
+	target := Decimal{}
+	assert.NoError(t, target.Scan([]byte("-12.30")))
+	assert.Equal(t, "-12.30", target.String())
+	assert.NoError(t, target.Scan("0.5"))
+	assert.Equal(t, "0.5", target.String())
+	assert.NoError(t, target.Scan(0.25))
+	assert.Equal(t, "0.25", target.String())
+	assert.NoError(t, target.Scan(int64(-3)))
+	assert.Equal(t, "-3", target.String())
+
+	assert.Equal(t, ErrInvalidFormat, target.Scan("abc"))
+	assertInvalidValueType(t, target.Scan(nil))
+	assertInvalidValueType(t, target.Scan(true))
+}
+
+func TestDecimalValue(t *testing.T) {
+	t.Parallel()
+	v, err := mustDecimal(t, "-0.50").Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "-0.50", v)
+}
+
+func TestDecimalMarshalJSON(t *testing.T) {
+	v := mustDecimal(t, "1234567890.123456789")
+	actual, err := json.Marshal(v)
+	assert.NoError(t, err)
+	assert.Equal(t, `"1234567890.123456789"`, string(actual))
+
+	SetDecimalJSONMode(DecimalJSONNumber)
+	defer SetDecimalJSONMode(DecimalJSONString)
+	actual, err = json.Marshal(v)
+	assert.NoError(t, err)
+	assert.Equal(t, `1234567890.123456789`, string(actual))
+
+	dst := Decimal{}
+	assert.NoError(t, json.Unmarshal(actual, &dst))
+	assert.Equal(t, v, dst)
+	assert.NoError(t, json.Unmarshal([]byte(`"-1.50"`), &dst))
+	assert.Equal(t, "-1.50", dst.String())
+	assert.Equal(t, ErrInvalidFormat, json.Unmarshal([]byte(`"1,5"`), &dst))
+}
+
+func TestParseDecimalType(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		typ       string
+		precision int
+		scale     int
+		ok        bool
+	}{
+		{"decimal(10,2)", 10, 2, true},
+		{"DECIMAL(10, 2) NOT NULL", 10, 2, true},
+		{"numeric(5)", 5, 0, true},
+		{"decimal", 0, 0, false},
+		{"decimal(a,2)", 0, 0, false},
+		{"varchar(10)", 0, 0, false},
+	} {
+		precision, scale, ok := parseDecimalType(tt.typ)
+		assert.Equal(t, tt.ok, ok, tt.typ)
+		assert.Equal(t, tt.precision, precision, tt.typ)
+		assert.Equal(t, tt.scale, scale, tt.typ)
+	}
+}
+
+func mustDecimal(t *testing.T, s string) Decimal {
+	d, err := NewDecimalFromString(s)
+	if err != nil {
+		t.Fatalf("invalid decimal %q: %v", s, err)
+	}
+	return d
+}
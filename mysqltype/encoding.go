@@ -0,0 +1,97 @@
+package mysqltype
+
+import (
+	"encoding/gob"
+	"encoding/xml"
+	"strconv"
+	"time"
+)
+
+// yamlMarshaler yaml.Marshaler of gopkg.in/yaml.v2 and gopkg.in/yaml.v3
+// It is declared here so that mysqltype does not depend on yaml packages.
+type yamlMarshaler interface {
+	MarshalYAML() (interface{}, error)
+}
+
+// yamlUnmarshaler yaml.Unmarshaler of gopkg.in/yaml.v2
+// gopkg.in/yaml.v3 also calls it as obsolete unmarshaler, since UnmarshalYAML(*yaml.Node) is not implemented.
+type yamlUnmarshaler interface {
+	UnmarshalYAML(unmarshal func(interface{}) error) error
+}
+
+// tomlMarshaler toml.Marshaler of github.com/BurntSushi/toml
+// It is declared here so that mysqltype does not depend on toml packages.
+type tomlMarshaler interface {
+	MarshalTOML() ([]byte, error)
+}
+
+// tomlUnmarshaler toml.Unmarshaler of github.com/BurntSushi/toml
+type tomlUnmarshaler interface {
+	UnmarshalTOML(v interface{}) error
+}
+
+// unmarshalXMLText decode the text of the element
+func unmarshalXMLText(d *xml.Decoder, start xml.StartElement) (string, error) {
+	var s string
+	if err := d.DecodeElement(&s, &start); err != nil {
+		return "", err
+	}
+	return s, nil
+}
+
+// unmarshalYAMLText decode YAML scalar as its text
+func unmarshalYAMLText(unmarshal func(interface{}) error) (string, error) {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return "", err
+	}
+	return s, nil
+}
+
+// tomlLocalTime take the wall clock of TOML local date-time in the location set by SetLocation
+// github.com/BurntSushi/toml decodes local date-time and local date in fixed zones of these names.
+func tomlLocalTime(t time.Time) time.Time {
+	switch t.Location().String() {
+	case "datetime-local", "date-local":
+		year, month, day := t.Date()
+		hour, min, sec := t.Clock()
+		return time.Date(year, month, day, hour, min, sec, t.Nanosecond(), location)
+	}
+	return t
+}
+
+// quoteTOML TOML basic string of s
+func quoteTOML(s string) []byte {
+	return []byte(strconv.Quote(s))
+}
+
+var _ gob.GobEncoder = Date{}
+var _ gob.GobDecoder = &Date{}
+var _ xml.Marshaler = Date{}
+var _ xml.Unmarshaler = &Date{}
+var _ xml.MarshalerAttr = Date{}
+var _ xml.UnmarshalerAttr = &Date{}
+var _ yamlMarshaler = Date{}
+var _ yamlUnmarshaler = &Date{}
+var _ tomlMarshaler = Date{}
+var _ tomlUnmarshaler = &Date{}
+
+var _ gob.GobEncoder = DateTime{}
+var _ gob.GobDecoder = &DateTime{}
+var _ xml.Marshaler = DateTime{}
+var _ xml.Unmarshaler = &DateTime{}
+var _ xml.MarshalerAttr = DateTime{}
+var _ xml.UnmarshalerAttr = &DateTime{}
+var _ yamlMarshaler = DateTime{}
+var _ yamlUnmarshaler = &DateTime{}
+var _ tomlMarshaler = DateTime{}
+var _ tomlUnmarshaler = &DateTime{}
+
+var _ gob.GobEncoder = Timestamp{}
+var _ gob.GobDecoder = &Timestamp{}
+var _ gob.GobEncoder = LocalDateTime{}
+var _ gob.GobDecoder = &LocalDateTime{}
+var _ gob.GobEncoder = Time{}
+var _ gob.GobDecoder = &Time{}
+var _ gob.GobEncoder = Year{}
+var _ gob.GobDecoder = &Year{}
@@ -0,0 +1,73 @@
+package mysqltype
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+type encodingTestStruct struct {
+	Date     Date     `yaml:"date" toml:"date"`
+	DateTime DateTime `yaml:"date_time" toml:"date_time"`
+}
+
+var encodingTestCases = []encodingTestStruct{
+	{NewDate(2018, 8, 20), NewDateTime(2018, 8, 20, 10, 20, 30, 123456000, time.UTC)},
+	{MinDate(), NewDateTime(2018, 8, 20, 10, 20, 30, 0, time.FixedZone("JST", 9*60*60))},
+	{MySQLZeroDate(), MySQLZeroDateTime()},
+}
+
+func TestYAMLRoundTrip(t *testing.T) {
+	t.Parallel()
+	for _, v := range encodingTestCases {
+		data, err := yaml.Marshal(v)
+		if !assert.NoError(t, err) {
+			continue
+		}
+		dst := encodingTestStruct{}
+		assert.NoError(t, yaml.Unmarshal(data, &dst), string(data))
+		assert.Equal(t, v.Date, dst.Date, string(data))
+		assert.True(t, v.DateTime.Equal(dst.DateTime), string(data))
+		assert.Equal(t, v.DateTime.IsMySQLZero(), dst.DateTime.IsMySQLZero(), string(data))
+	}
+
+	data, err := yaml.Marshal(encodingTestCases[0])
+	assert.NoError(t, err)
+	assert.Equal(t, "date: \"2018-08-20\"\ndate_time: \"2018-08-20T10:20:30.123456Z\"\n", string(data))
+
+	_, err = yaml.Marshal(encodingTestStruct{Date: MaxDate().AddDate(0, 0, 1)})
+	assertOutOfRange(t, err)
+	assertOutOfRange(t, yaml.Unmarshal([]byte("date: 0999-12-31\n"), &encodingTestStruct{}))
+}
+
+func TestTOMLRoundTrip(t *testing.T) {
+	t.Parallel()
+	for _, v := range encodingTestCases {
+		buf := &bytes.Buffer{}
+		if !assert.NoError(t, toml.NewEncoder(buf).Encode(v)) {
+			continue
+		}
+		dst := encodingTestStruct{}
+		_, err := toml.Decode(buf.String(), &dst)
+		assert.NoError(t, err, buf.String())
+		assert.Equal(t, v.Date, dst.Date, buf.String())
+		assert.True(t, v.DateTime.Equal(dst.DateTime), buf.String())
+		assert.Equal(t, v.DateTime.IsMySQLZero(), dst.DateTime.IsMySQLZero(), buf.String())
+	}
+
+	dst := encodingTestStruct{}
+	_, err := toml.Decode("date = 2018-08-20\ndate_time = 2018-08-20T10:20:30\n", &dst)
+	assert.NoError(t, err)
+	assert.Equal(t, NewDate(2018, 8, 20), dst.Date)
+	assert.Equal(t, NewDateTime(2018, 8, 20, 10, 20, 30, 0, location), dst.DateTime)
+
+	err = toml.NewEncoder(&bytes.Buffer{}).Encode(encodingTestStruct{Date: MaxDate().AddDate(0, 0, 1)})
+	assertOutOfRange(t, err)
+	// toml.ParseError keeps only the message of the error from UnmarshalTOML.
+	_, err = toml.Decode("date = 0999-12-31\n", &dst)
+	assert.Contains(t, err.Error(), ErrOutOfRange.Error())
+}
@@ -0,0 +1,173 @@
+//go:build go1.18
+// +build go1.18
+
+package mysqltype
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+)
+
+// EnumValuer string type declaring the values of MySQL ENUM
+// EnumValues returns every value in declaration order, which is the order of the ENUM column.
+// It is called on the zero value.
+//
+//	type Status string
+//
+//	const (
+//		StatusActive   Status = "active"
+//		StatusInactive Status = "inactive"
+//	)
+//
+//	func (Status) EnumValues() []Status { return []Status{StatusActive, StatusInactive} }
+type EnumValuer[T any] interface {
+	~string
+	EnumValues() []T
+}
+
+// Enum support MySQL ENUM type of values declared by T
+// https://dev.mysql.com/doc/refman/8.0/en/enum.html
+// gorm AutoMigrate creates ENUM('active','inactive') column for Enum[Status].
+// Only the declared values are accepted, and the zero value is no value.
+// It requires Go 1.18.
+type Enum[T EnumValuer[T]] struct {
+	src T
+}
+
+// NewEnum Create new Enum of v
+// It returns UnknownEnumValueError if v is not declared by T.
+func NewEnum[T EnumValuer[T]](v T) (Enum[T], error) {
+	for _, value := range v.EnumValues() {
+		if v == value {
+			return Enum[T]{src: v}, nil
+		}
+	}
+	return Enum[T]{}, &UnknownEnumValueError{Value: string(v), Values: enumNames(v.EnumValues())}
+}
+
+// Get value of e
+func (e Enum[T]) Get() T {
+	return e.src
+}
+
+// IsZero reports whether e is no value
+func (e Enum[T]) IsZero() bool {
+	return e.src == ""
+}
+
+// String name of the value
+func (e Enum[T]) String() string {
+	return string(e.src)
+}
+
+// UnmarshalText parse name of the value as NewEnum
+// Empty text is the zero value as MarshalText formats it.
+func (e *Enum[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*e = Enum[T]{}
+		return nil
+	}
+	v, err := NewEnum(T(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
+// MarshalText format as name of the value
+func (e Enum[T]) MarshalText() ([]byte, error) {
+	return []byte(e.src), nil
+}
+
+// UnmarshalJSON parse JSON string of name of the value
+func (e *Enum[T]) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return e.UnmarshalText([]byte(s))
+}
+
+// MarshalJSON format as JSON string of name of the value
+func (e Enum[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(e.src))
+}
+
+// GobEncode encode as MarshalText
+func (e Enum[T]) GobEncode() ([]byte, error) {
+	return e.MarshalText()
+}
+
+// GobDecode decode as UnmarshalText
+func (e *Enum[T]) GobDecode(data []byte) error {
+	return e.UnmarshalText(data)
+}
+
+// GormDataType column type for gorm AutoMigrate, e.g. ENUM('active','inactive')
+func (Enum[T]) GormDataType(gorm.Dialect) string {
+	var zero T
+	return enumDataType(enumNames(zero.EnumValues()))
+}
+
+// enumAssertion EnumValuer to assert interfaces of Enum
+type enumAssertion string
+
+func (enumAssertion) EnumValues() []enumAssertion { return nil }
+
+var _ driver.Valuer = Enum[enumAssertion]{}
+var _ sql.Scanner = &Enum[enumAssertion]{}
+var _ encoding.TextUnmarshaler = &Enum[enumAssertion]{}
+var _ encoding.TextMarshaler = Enum[enumAssertion]{}
+var _ json.Marshaler = Enum[enumAssertion]{}
+var _ json.Unmarshaler = &Enum[enumAssertion]{}
+var _ gob.GobEncoder = Enum[enumAssertion]{}
+var _ gob.GobDecoder = &Enum[enumAssertion]{}
+
+// Scan for sql.Scanner
+func (e *Enum[T]) Scan(value interface{}) error {
+	switch src := value.(type) {
+	case []byte:
+		return e.UnmarshalText(src)
+	case string:
+		return e.UnmarshalText([]byte(src))
+	default:
+		return &InvalidValueTypeError{Value: value, Target: "Enum"}
+	}
+}
+
+// Value for driver.Valuer
+// It returns UnknownEnumValueError for the zero value, so that rows without value are not saved.
+func (e Enum[T]) Value() (driver.Value, error) {
+	v, err := NewEnum(e.src)
+	if err != nil {
+		return nil, err
+	}
+	return string(v.src), nil
+}
+
+func enumNames[T ~string](values []T) []string {
+	names := make([]string, len(values))
+	for i, v := range values {
+		names[i] = string(v)
+	}
+	return names
+}
+
+// enumQuoter escape backslashes and quotes in MySQL string literal
+var enumQuoter = strings.NewReplacer(`\`, `\\`, `'`, `''`)
+
+// enumDataType MySQL ENUM type of names quoted as string literals
+func enumDataType(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = "'" + enumQuoter.Replace(name) + "'"
+	}
+	return "ENUM(" + strings.Join(quoted, ",") + ")"
+}
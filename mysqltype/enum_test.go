@@ -0,0 +1,139 @@
+//go:build go1.18
+// +build go1.18
+
+package mysqltype
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type enumTestStatus string
+
+const (
+	enumTestStatusActive   enumTestStatus = "active"
+	enumTestStatusInactive enumTestStatus = "inactive"
+	enumTestStatusQuoted   enumTestStatus = `it's \`
+)
+
+func (enumTestStatus) EnumValues() []enumTestStatus {
+	return []enumTestStatus{enumTestStatusInactive, enumTestStatusActive, enumTestStatusQuoted}
+}
+
+type EnumFieldTestStruct struct {
+	ID     int
+	Status Enum[enumTestStatus] `gorm:"not null"`
+	Prev   *Enum[enumTestStatus]
+}
+
+func TestEnumField(t *testing.T) {
+	t.Parallel()
+	assert.NoError(t, DB.AutoMigrate(&EnumFieldTestStruct{}).Error)
+
+	for _, status := range enumTestStatus("").EnumValues() {
+		target := &EnumFieldTestStruct{Status: mustEnum(t, status)}
+		assert.NoError(t, DB.Create(target).Error)
+		dst := &EnumFieldTestStruct{ID: target.ID}
+		assert.NoError(t, DB.First(dst).Error)
+		assert.Equal(t, target, dst)
+	}
+
+	prev := mustEnum(t, enumTestStatusActive)
+	target := &EnumFieldTestStruct{Status: mustEnum(t, enumTestStatusInactive), Prev: &prev}
+	assert.NoError(t, DB.Create(target).Error)
+	dst := &EnumFieldTestStruct{ID: target.ID}
+	assert.NoError(t, DB.First(dst).Error)
+	assert.Equal(t, target, dst)
+
+	err := DB.Create(&EnumFieldTestStruct{}).Error
+	assert.Truef(t, errors.Is(err, ErrUnknownEnumValue), "unexpected error: `%v`", err)
+}
+
+func TestNewEnum(t *testing.T) {
+	t.Parallel()
+	e, err := NewEnum(enumTestStatusActive)
+	assert.NoError(t, err)
+	assert.Equal(t, enumTestStatusActive, e.Get())
+	assert.Equal(t, "active", e.String())
+	assert.False(t, e.IsZero())
+	assert.True(t, Enum[enumTestStatus]{}.IsZero())
+
+	_, err = NewEnum(enumTestStatus("deleted"))
+	assertUnknownEnumValue(t, err)
+	assert.EqualError(t, err, `unknown enum value: "deleted" is not in ["inactive" "active" "it's \\"]`)
+	_, err = NewEnum(enumTestStatus("Active"))
+	assertUnknownEnumValue(t, err)
+}
+
+func TestEnumScan(t *testing.T) {
+	t.Parallel()
+	target := Enum[enumTestStatus]{}
+	assert.NoError(t, target.Scan([]byte("inactive")))
+	assert.Equal(t, enumTestStatusInactive, target.Get())
+	assert.NoError(t, target.Scan("active"))
+	assert.Equal(t, enumTestStatusActive, target.Get())
+
+	assertUnknownEnumValue(t, target.Scan("deleted"))
+	assertInvalidValueType(t, target.Scan(nil))
+	assertInvalidValueType(t, target.Scan(int64(1)))
+}
+
+func TestEnumValue(t *testing.T) {
+	t.Parallel()
+	v, err := mustEnum(t, enumTestStatusActive).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "active", v)
+	_, err = Enum[enumTestStatus]{}.Value()
+	assertUnknownEnumValue(t, err)
+}
+
+func TestEnumMarshalJSON(t *testing.T) {
+	t.Parallel()
+	src := []Enum[enumTestStatus]{mustEnum(t, enumTestStatusActive), {}}
+	actual, err := json.Marshal(src)
+	assert.NoError(t, err)
+	assert.Equal(t, `["active",""]`, string(actual))
+
+	var dst []Enum[enumTestStatus]
+	assert.NoError(t, json.Unmarshal(actual, &dst))
+	assert.Equal(t, src, dst)
+	assertUnknownEnumValue(t, json.Unmarshal([]byte(`"deleted"`), &Enum[enumTestStatus]{}))
+	assert.Error(t, json.Unmarshal([]byte(`1`), &Enum[enumTestStatus]{}))
+}
+
+func TestEnumMarshalText(t *testing.T) {
+	t.Parallel()
+	actual, err := mustEnum(t, enumTestStatusInactive).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "inactive", string(actual))
+	dst := Enum[enumTestStatus]{}
+	assert.NoError(t, dst.UnmarshalText(actual))
+	assert.Equal(t, enumTestStatusInactive, dst.Get())
+}
+
+func TestEnumGormDataType(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, `ENUM('inactive','active','it''s \\')`, Enum[enumTestStatus]{}.GormDataType(nil))
+}
+
+func TestEnumConformance(t *testing.T) {
+	t.Parallel()
+	for _, c := range []conformanceCase{
+		{"Enum", mustEnum(t, enumTestStatusQuoted), false},
+		{"Enum/zero", Enum[enumTestStatus]{}, true},
+	} {
+		assertConformance(t, c)
+		assertConformanceByValue(t, c)
+	}
+}
+
+func mustEnum[T EnumValuer[T]](t *testing.T, v T) Enum[T] {
+	e, err := NewEnum(v)
+	if err != nil {
+		t.Fatalf("invalid enum %q: %v", v, err)
+	}
+	return e
+}
@@ -1,9 +1,88 @@
 package mysqltype
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors of this package are the Err* values below, or the *Error types which wrap one of them,
+// so that errors.Is can test them regardless of the details.
 
 // ErrInvalidValueType invalid value type
 var ErrInvalidValueType = errors.New("invalid value type")
 
 // ErrOutOfRange out of range
 var ErrOutOfRange = errors.New("out of range")
+
+// ErrInvalidFormat invalid format
+var ErrInvalidFormat = errors.New("invalid format")
+
+// OutOfRangeError reports the value out of range
+// Min and Max are the inclusive bounds of the type.
+type OutOfRangeError struct {
+	Value interface{}
+	Min   interface{}
+	Max   interface{}
+}
+
+func (e *OutOfRangeError) Error() string {
+	return fmt.Sprintf("%s: %v is not in [%v, %v]", ErrOutOfRange, e.Value, e.Min, e.Max)
+}
+
+// Unwrap returns ErrOutOfRange
+func (e *OutOfRangeError) Unwrap() error {
+	return ErrOutOfRange
+}
+
+// ErrZeroDate MySQL zero date is not allowed
+var ErrZeroDate = errors.New("zero date")
+
+// InvalidValueTypeError reports the value type Scan can not handle
+// Target is the name of the type in this package.
+type InvalidValueTypeError struct {
+	Value  interface{}
+	Target string
+}
+
+func (e *InvalidValueTypeError) Error() string {
+	return fmt.Sprintf("%s: can not scan %T into mysqltype.%s", ErrInvalidValueType, e.Value, e.Target)
+}
+
+// Unwrap returns ErrInvalidValueType
+func (e *InvalidValueTypeError) Unwrap() error {
+	return ErrInvalidValueType
+}
+
+// ErrUnknownFormat format is not registered
+var ErrUnknownFormat = errors.New("unknown format")
+
+// ErrUnboundedRange range is unbounded
+var ErrUnboundedRange = errors.New("unbounded range")
+
+// ErrDivisionByZero division by zero
+var ErrDivisionByZero = errors.New("division by zero")
+
+// ErrUnknownCurrency currency code is not registered
+var ErrUnknownCurrency = errors.New("unknown currency")
+
+// ErrCurrencyMismatch money of different currencies
+var ErrCurrencyMismatch = errors.New("currency mismatch")
+
+// ErrUnknownEnumValue value is not declared in enum
+var ErrUnknownEnumValue = errors.New("unknown enum value")
+
+// UnknownEnumValueError reports the value not declared in enum
+// Values are the values declared in enum, in order.
+type UnknownEnumValueError struct {
+	Value  string
+	Values []string
+}
+
+func (e *UnknownEnumValueError) Error() string {
+	return fmt.Sprintf("%s: %q is not in %q", ErrUnknownEnumValue, e.Value, e.Values)
+}
+
+// Unwrap returns ErrUnknownEnumValue
+func (e *UnknownEnumValueError) Unwrap() error {
+	return ErrUnknownEnumValue
+}
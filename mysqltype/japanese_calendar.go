@@ -0,0 +1,191 @@
+package mysqltype
+
+import (
+	"sync"
+	"time"
+)
+
+// JapaneseCalendar Calendar of Saturdays, Sundays and Japanese national holidays
+// Holidays are computed by the Act on National Holidays including substitute and citizens' holidays.
+// They are exact from 2000 to the current year, and later years assume the current law.
+// Vernal and autumnal equinox days are estimated by the usual formula, which is valid until 2099.
+// The zero value is ready to use, and holidays of each year are cached on first use.
+type JapaneseCalendar struct {
+	mu    sync.Mutex
+	years map[int]map[Date]string
+}
+
+// NewJapaneseCalendar Create new JapaneseCalendar
+func NewJapaneseCalendar() *JapaneseCalendar {
+	return &JapaneseCalendar{years: map[int]map[Date]string{}}
+}
+
+// IsHoliday reports whether d is a national holiday, ignoring weekends
+func (c *JapaneseCalendar) IsHoliday(d Date) bool {
+	_, ok := c.HolidayName(d)
+	return ok
+}
+
+// HolidayName name of the national holiday d in Japanese
+// It reports false if d is not a national holiday.
+func (c *JapaneseCalendar) HolidayName(d Date) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.years == nil {
+		c.years = map[int]map[Date]string{}
+	}
+	holidays, ok := c.years[d.Year()]
+	if !ok {
+		holidays = japaneseHolidays(d.Year())
+		c.years[d.Year()] = holidays
+	}
+	name, ok := holidays[d]
+	return name, ok
+}
+
+// IsBusinessDay reports whether d is neither Saturday, Sunday nor a national holiday
+func (c *JapaneseCalendar) IsBusinessDay(d Date) bool {
+	if w := d.Weekday(); w == time.Saturday || w == time.Sunday {
+		return false
+	}
+	return !c.IsHoliday(d)
+}
+
+// japaneseHolidays national holidays in year
+func japaneseHolidays(year int) map[Date]string {
+	holidays := map[Date]string{}
+	add := func(month time.Month, day int, name string) {
+		holidays[NewDate(year, month, day)] = name
+	}
+
+	add(time.January, 1, "元日")
+	if year >= 2000 {
+		add(time.January, nthMonday(year, time.January, 2), "成人の日")
+	} else {
+		add(time.January, 15, "成人の日")
+	}
+	add(time.February, 11, "建国記念の日")
+	if year >= 2020 {
+		add(time.February, 23, "天皇誕生日")
+	}
+	add(time.March, vernalEquinoxDay(year), "春分の日")
+	switch {
+	case year >= 2007:
+		add(time.April, 29, "昭和の日")
+	case year >= 1989:
+		add(time.April, 29, "みどりの日")
+	default:
+		add(time.April, 29, "天皇誕生日")
+	}
+	add(time.May, 3, "憲法記念日")
+	if year >= 2007 {
+		add(time.May, 4, "みどりの日")
+	}
+	add(time.May, 5, "こどもの日")
+	switch {
+	case year == 2020:
+		add(time.July, 23, "海の日")
+	case year == 2021:
+		add(time.July, 22, "海の日")
+	case year >= 2003:
+		add(time.July, nthMonday(year, time.July, 3), "海の日")
+	case year >= 1996:
+		add(time.July, 20, "海の日")
+	}
+	switch {
+	case year == 2020:
+		add(time.August, 10, "山の日")
+	case year == 2021:
+		add(time.August, 8, "山の日")
+	case year >= 2016:
+		add(time.August, 11, "山の日")
+	}
+	if year >= 2003 {
+		add(time.September, nthMonday(year, time.September, 3), "敬老の日")
+	} else {
+		add(time.September, 15, "敬老の日")
+	}
+	add(time.September, autumnalEquinoxDay(year), "秋分の日")
+	switch {
+	case year == 2020:
+		add(time.July, 24, "スポーツの日")
+	case year == 2021:
+		add(time.July, 23, "スポーツの日")
+	case year >= 2020:
+		add(time.October, nthMonday(year, time.October, 2), "スポーツの日")
+	case year >= 2000:
+		add(time.October, nthMonday(year, time.October, 2), "体育の日")
+	default:
+		add(time.October, 10, "体育の日")
+	}
+	add(time.November, 3, "文化の日")
+	add(time.November, 23, "勤労感謝の日")
+	if 1989 <= year && year <= 2018 {
+		add(time.December, 23, "天皇誕生日")
+	}
+	if year == 2019 {
+		add(time.May, 1, "即位の日")
+		add(time.October, 22, "即位礼正殿の儀の行われる日")
+	}
+
+	// citizens' holiday: a day between two national holidays
+	var citizens []Date
+	for d := range holidays {
+		next := d.AddDate(0, 0, 1)
+		if _, ok := holidays[next]; ok {
+			continue
+		}
+		if _, ok := holidays[next.AddDate(0, 0, 1)]; ok && next.Year() == year {
+			citizens = append(citizens, next)
+		}
+	}
+	for _, d := range citizens {
+		if d.Weekday() != time.Sunday {
+			holidays[d] = "国民の休日"
+		}
+	}
+
+	// substitute holiday: the first non-holiday after a national holiday on Sunday
+	var substitutes []Date
+	for d := range holidays {
+		if d.Weekday() != time.Sunday {
+			continue
+		}
+		for {
+			d = d.AddDate(0, 0, 1)
+			if _, ok := holidays[d]; !ok {
+				break
+			}
+			if year < 2007 {
+				d = Date{}
+				break
+			}
+		}
+		if !d.IsZero() && d.Year() == year {
+			substitutes = append(substitutes, d)
+		}
+	}
+	for _, d := range substitutes {
+		holidays[d] = "振替休日"
+	}
+	return holidays
+}
+
+// nthMonday day of the nth Monday in month
+func nthMonday(year int, month time.Month, n int) int {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC).Weekday()
+	return 1 + (int(time.Monday-first)+7)%7 + (n-1)*7
+}
+
+func vernalEquinoxDay(year int) int {
+	return equinoxDay(year, 20.8431)
+}
+
+func autumnalEquinoxDay(year int) int {
+	return equinoxDay(year, 23.2488)
+}
+
+// equinoxDay estimate equinox day from its base day in 1980
+func equinoxDay(year int, base float64) int {
+	return int(base+0.242194*float64(year-1980)) - (year-1980)/4
+}
@@ -0,0 +1,70 @@
+package mysqltype
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJapaneseCalendarHolidayName(t *testing.T) {
+	t.Parallel()
+	cal := NewJapaneseCalendar()
+	tests := []struct {
+		year     int
+		expected []Date
+	}{
+		{2019, []Date{
+			NewDate(2019, 1, 1), NewDate(2019, 1, 14), NewDate(2019, 2, 11), NewDate(2019, 3, 21),
+			NewDate(2019, 4, 29), NewDate(2019, 4, 30), NewDate(2019, 5, 1), NewDate(2019, 5, 2),
+			NewDate(2019, 5, 3), NewDate(2019, 5, 4), NewDate(2019, 5, 5), NewDate(2019, 5, 6),
+			NewDate(2019, 7, 15), NewDate(2019, 8, 11), NewDate(2019, 8, 12), NewDate(2019, 9, 16),
+			NewDate(2019, 9, 23), NewDate(2019, 10, 14), NewDate(2019, 10, 22), NewDate(2019, 11, 3),
+			NewDate(2019, 11, 4), NewDate(2019, 11, 23),
+		}},
+		{2020, []Date{
+			NewDate(2020, 1, 1), NewDate(2020, 1, 13), NewDate(2020, 2, 11), NewDate(2020, 2, 23),
+			NewDate(2020, 2, 24), NewDate(2020, 3, 20), NewDate(2020, 4, 29), NewDate(2020, 5, 3),
+			NewDate(2020, 5, 4), NewDate(2020, 5, 5), NewDate(2020, 5, 6), NewDate(2020, 7, 23),
+			NewDate(2020, 7, 24), NewDate(2020, 8, 10), NewDate(2020, 9, 21), NewDate(2020, 9, 22),
+			NewDate(2020, 11, 3), NewDate(2020, 11, 23),
+		}},
+	}
+	for _, tt := range tests {
+		var actual []Date
+		it := NewDateRange(NewDate(tt.year, 1, 1), NewDate(tt.year, 12, 31)).Days()
+		for it.Next() {
+			if cal.IsHoliday(it.Date()) {
+				actual = append(actual, it.Date())
+			}
+		}
+		assert.Equal(t, tt.expected, actual)
+	}
+
+	name, ok := cal.HolidayName(NewDate(2009, 9, 22))
+	assert.True(t, ok)
+	assert.Equal(t, "国民の休日", name)
+
+	name, ok = cal.HolidayName(NewDate(2023, 1, 2))
+	assert.True(t, ok)
+	assert.Equal(t, "振替休日", name)
+
+	_, ok = cal.HolidayName(NewDate(2023, 1, 3))
+	assert.False(t, ok)
+}
+
+func TestJapaneseCalendarIsBusinessDay(t *testing.T) {
+	t.Parallel()
+	cal := NewJapaneseCalendar()
+	assert.True(t, NewDate(2019, 4, 26).IsBusinessDay(cal))
+	assert.False(t, NewDate(2019, 4, 27).IsBusinessDay(cal))
+	assert.False(t, NewDate(2019, 5, 6).IsBusinessDay(cal))
+	assert.Equal(t, NewDate(2019, 5, 7), NewDate(2019, 4, 26).NextBusinessDay(cal))
+}
+
+func TestNthMonday(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, 14, nthMonday(2019, time.January, 2))
+	assert.Equal(t, 1, nthMonday(2018, time.January, 1))
+	assert.Equal(t, 21, nthMonday(2020, time.September, 3))
+}
@@ -0,0 +1,165 @@
+package mysqltype
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/gob"
+	"encoding/json"
+
+	"github.com/jinzhu/gorm"
+)
+
+// JSON support MySQL JSON type as raw JSON document
+// https://dev.mysql.com/doc/refman/8.0/en/json.html
+// The zero value is NULL, which is different from JSON null literal.
+// Scan and Value reject text which is not valid JSON with ErrInvalidFormat.
+type JSON struct {
+	src []byte `gorm:"type:json"`
+}
+
+// NewJSON Create new JSON by marshalling v with encoding/json
+func NewJSON(v interface{}) (JSON, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return JSON{}, err
+	}
+	return JSON{src: data}, nil
+}
+
+// NewJSONFromBytes Create new JSON from JSON text
+// data is copied, and it returns ErrInvalidFormat if data is not valid JSON.
+func NewJSONFromBytes(data []byte) (JSON, error) {
+	if !json.Valid(data) {
+		return JSON{}, ErrInvalidFormat
+	}
+	return JSON{src: append([]byte{}, data...)}, nil
+}
+
+// NullJSON NULL, same as the zero value
+func NullJSON() JSON {
+	return JSON{}
+}
+
+// IsNull reports whether j is NULL
+func (j JSON) IsNull() bool {
+	return j.src == nil
+}
+
+// Bytes JSON text, nil if j is NULL
+// It must not be modified.
+func (j JSON) Bytes() []byte {
+	return j.src
+}
+
+// String JSON text, "null" if j is NULL
+func (j JSON) String() string {
+	if j.src == nil {
+		return "null"
+	}
+	return string(j.src)
+}
+
+// Unmarshal unmarshal j into v with encoding/json
+// NULL is unmarshalled as JSON null.
+func (j JSON) Unmarshal(v interface{}) error {
+	return json.Unmarshal([]byte(j.String()), v)
+}
+
+// Equal reports whether both are NULL or both have same JSON text ignoring insignificant white space
+// Objects with different key order are not equal.
+func (j JSON) Equal(u JSON) bool {
+	if j.src == nil || u.src == nil {
+		return j.src == nil && u.src == nil
+	}
+	a, b := &bytes.Buffer{}, &bytes.Buffer{}
+	if json.Compact(a, j.src) != nil || json.Compact(b, u.src) != nil {
+		return bytes.Equal(j.src, u.src)
+	}
+	return bytes.Equal(a.Bytes(), b.Bytes())
+}
+
+// UnmarshalJSON keep data as JSON text
+// JSON null is unmarshalled as NULL.
+func (j *JSON) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*j = JSON{}
+		return nil
+	}
+	v, err := NewJSONFromBytes(data)
+	if err != nil {
+		return err
+	}
+	*j = v
+	return nil
+}
+
+// MarshalJSON JSON text as it is, NULL is marshalled to JSON null
+func (j JSON) MarshalJSON() ([]byte, error) {
+	return []byte(j.String()), nil
+}
+
+// GobEncode encode JSON text, NULL is encoded as empty
+func (j JSON) GobEncode() ([]byte, error) {
+	return j.src, nil
+}
+
+// GobDecode decode JSON text, empty is decoded as NULL
+func (j *JSON) GobDecode(data []byte) error {
+	if len(data) == 0 {
+		*j = JSON{}
+		return nil
+	}
+	v, err := NewJSONFromBytes(data)
+	if err != nil {
+		return err
+	}
+	*j = v
+	return nil
+}
+
+// GormDataType column type for gorm AutoMigrate
+func (JSON) GormDataType(gorm.Dialect) string {
+	return "json"
+}
+
+var _ driver.Valuer = JSON{}
+var _ sql.Scanner = &JSON{}
+var _ json.Marshaler = JSON{}
+var _ json.Unmarshaler = &JSON{}
+var _ gob.GobEncoder = JSON{}
+var _ gob.GobDecoder = &JSON{}
+
+// Scan for sql.Scanner
+func (j *JSON) Scan(value interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		*j = JSON{}
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return &InvalidValueTypeError{Value: value, Target: "JSON"}
+	}
+	dst, err := NewJSONFromBytes(data)
+	if err != nil {
+		return err
+	}
+	*j = dst
+	return nil
+}
+
+// Value for driver.Valuer
+// It returns string, since MySQL rejects JSON in binary character set.
+func (j JSON) Value() (driver.Value, error) {
+	if j.src == nil {
+		return nil, nil
+	}
+	if !json.Valid(j.src) {
+		return nil, ErrInvalidFormat
+	}
+	return string(j.src), nil
+}
@@ -0,0 +1,178 @@
+package mysqltype
+
+import (
+	"strconv"
+	"time"
+)
+
+// JSONFormat converts time.Time from and to JSON value
+// Register it by RegisterJSONFormat to use its name in SetDateJSONFormat, SetDateTimeJSONFormat and struct tags.
+type JSONFormat interface {
+	MarshalJSONTime(t time.Time) ([]byte, error)
+	UnmarshalJSONTime(data []byte) (time.Time, error)
+}
+
+// Names of built-in JSONFormat
+const (
+	// JSONFormatRFC3339 RFC 3339 string with nanoseconds as time.Time, e.g. "2018-08-20T10:20:30.123Z"
+	JSONFormatRFC3339 = "rfc3339"
+	// JSONFormatDate date string, e.g. "2018-08-20"
+	JSONFormatDate = "date"
+	// JSONFormatDateTime MySQL DATETIME string, e.g. "2018-08-20 10:20:30.123"
+	JSONFormatDateTime = "datetime"
+	// JSONFormatUnix unix time in seconds as JSON number, e.g. 1534760430
+	JSONFormatUnix = "unix"
+	// JSONFormatUnixMilli unix time in milliseconds as JSON number, e.g. 1534760430123
+	JSONFormatUnixMilli = "unixmilli"
+)
+
+var jsonFormats = map[string]JSONFormat{
+	JSONFormatRFC3339:   rfc3339JSONFormat{},
+	JSONFormatDate:      LayoutJSONFormat(dateFormatLayout),
+	JSONFormatDateTime:  LayoutJSONFormat("2006-01-02 15:04:05.999999"),
+	JSONFormatUnix:      unixJSONFormat{unit: time.Second},
+	JSONFormatUnixMilli: unixJSONFormat{unit: time.Millisecond},
+}
+
+var (
+	dateJSONFormat     = jsonFormats[JSONFormatDate]
+	dateTimeJSONFormat = jsonFormats[JSONFormatRFC3339]
+)
+
+// RegisterJSONFormat register f as name, replacing the format of same name
+// It is not safe to call concurrently with marshalling, so call it on initialization.
+func RegisterJSONFormat(name string, f JSONFormat) {
+	jsonFormats[name] = f
+}
+
+// LookupJSONFormat registered JSONFormat of name
+// It returns ErrUnknownFormat if name is not registered.
+func LookupJSONFormat(name string) (JSONFormat, error) {
+	f, ok := jsonFormats[name]
+	if !ok {
+		return nil, ErrUnknownFormat
+	}
+	return f, nil
+}
+
+// SetDateJSONFormat set JSONFormat of Date and NullDate by its name, JSONFormatDate by default
+// UnmarshalJSON also accepts JSONFormatDate and JSONFormatRFC3339 regardless of the format.
+// It is not safe to call concurrently with marshalling, so call it on initialization.
+func SetDateJSONFormat(name string) error {
+	f, err := LookupJSONFormat(name)
+	if err != nil {
+		return err
+	}
+	dateJSONFormat = f
+	return nil
+}
+
+// SetDateTimeJSONFormat set JSONFormat of DateTime, Timestamp and their Null types by its name, JSONFormatRFC3339 by default
+// UnmarshalJSON also accepts JSONFormatRFC3339 regardless of the format.
+// It is not safe to call concurrently with marshalling, so call it on initialization.
+func SetDateTimeJSONFormat(name string) error {
+	f, err := LookupJSONFormat(name)
+	if err != nil {
+		return err
+	}
+	dateTimeJSONFormat = f
+	return nil
+}
+
+// LayoutJSONFormat JSONFormat of JSON string formatted by layout as time.Format
+// Text without time zone is parsed in the location set by SetLocation.
+func LayoutJSONFormat(layout string) JSONFormat {
+	return layoutJSONFormat{layout: layout}
+}
+
+type layoutJSONFormat struct {
+	layout string
+}
+
+func (f layoutJSONFormat) MarshalJSONTime(t time.Time) ([]byte, error) {
+	return []byte(strconv.Quote(t.Format(f.layout))), nil
+}
+
+func (f layoutJSONFormat) UnmarshalJSONTime(data []byte) (time.Time, error) {
+	s, err := strconv.Unquote(string(data))
+	if err != nil {
+		return time.Time{}, ErrInvalidFormat
+	}
+	return time.ParseInLocation(f.layout, s, location)
+}
+
+// rfc3339JSONFormat behavior as time.Time
+type rfc3339JSONFormat struct{}
+
+func (rfc3339JSONFormat) MarshalJSONTime(t time.Time) ([]byte, error) {
+	return t.MarshalJSON()
+}
+
+func (rfc3339JSONFormat) UnmarshalJSONTime(data []byte) (time.Time, error) {
+	t := time.Time{}
+	err := t.UnmarshalJSON(data)
+	return t, err
+}
+
+// unixJSONFormat unix time in unit as JSON number
+type unixJSONFormat struct {
+	unit time.Duration
+}
+
+func (f unixJSONFormat) MarshalJSONTime(t time.Time) ([]byte, error) {
+	perSecond := int64(time.Second / f.unit)
+	n := t.Unix()*perSecond + int64(t.Nanosecond())/int64(f.unit)
+	return []byte(strconv.FormatInt(n, 10)), nil
+}
+
+func (f unixJSONFormat) UnmarshalJSONTime(data []byte) (time.Time, error) {
+	n, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return time.Time{}, ErrInvalidFormat
+	}
+	perSecond := int64(time.Second / f.unit)
+	sec, frac := n/perSecond, n%perSecond
+	if frac < 0 {
+		sec, frac = sec-1, frac+perSecond
+	}
+	return time.Unix(sec, frac*int64(f.unit)).In(location), nil
+}
+
+// unmarshalJSONTime parse data with formats in order
+// It returns the error of the first format if none of formats matches.
+func unmarshalJSONTime(data []byte, formats ...JSONFormat) (time.Time, error) {
+	var firstErr error
+	for _, f := range formats {
+		t, err := f.UnmarshalJSONTime(data)
+		if err == nil {
+			return t, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return time.Time{}, firstErr
+}
+
+// jsonFormatMarshaler marshal JSON in the format
+type jsonFormatMarshaler interface {
+	marshalJSONWith(f JSONFormat) ([]byte, error)
+}
+
+// jsonFormatUnmarshaler unmarshal JSON in the format
+type jsonFormatUnmarshaler interface {
+	unmarshalJSONWith(data []byte, f JSONFormat) error
+}
+
+var _ jsonFormatMarshaler = Date{}
+var _ jsonFormatUnmarshaler = &Date{}
+var _ jsonFormatMarshaler = DateTime{}
+var _ jsonFormatUnmarshaler = &DateTime{}
+var _ jsonFormatMarshaler = Timestamp{}
+var _ jsonFormatUnmarshaler = &Timestamp{}
+var _ jsonFormatMarshaler = NullDate{}
+var _ jsonFormatUnmarshaler = &NullDate{}
+var _ jsonFormatMarshaler = NullDateTime{}
+var _ jsonFormatUnmarshaler = &NullDateTime{}
+var _ jsonFormatMarshaler = NullTimestamp{}
+var _ jsonFormatUnmarshaler = &NullTimestamp{}
@@ -0,0 +1,107 @@
+package mysqltype
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONFormat(t *testing.T) {
+	t.Parallel()
+	src := time.Date(2018, 8, 20, 10, 20, 30, 123456789, time.UTC)
+	tests := []struct {
+		name     string
+		expected string
+		parsed   time.Time
+	}{
+		{JSONFormatRFC3339, `"2018-08-20T10:20:30.123456789Z"`, src},
+		{JSONFormatDate, `"2018-08-20"`, time.Date(2018, 8, 20, 0, 0, 0, 0, time.UTC)},
+		{JSONFormatDateTime, `"2018-08-20 10:20:30.123456"`, time.Date(2018, 8, 20, 10, 20, 30, 123456000, time.UTC)},
+		{JSONFormatUnix, `1534760430`, time.Date(2018, 8, 20, 10, 20, 30, 0, time.UTC)},
+		{JSONFormatUnixMilli, `1534760430123`, time.Date(2018, 8, 20, 10, 20, 30, 123000000, time.UTC)},
+	}
+	for _, tt := range tests {
+		f, err := LookupJSONFormat(tt.name)
+		assert.NoError(t, err)
+		actual, err := f.MarshalJSONTime(src)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.expected, string(actual), tt.name)
+
+		parsed, err := f.UnmarshalJSONTime(actual)
+		assert.NoError(t, err)
+		assertTimeEquals(t, tt.parsed, parsed)
+	}
+
+	parsed, err := jsonFormats[JSONFormatUnixMilli].UnmarshalJSONTime([]byte("-1"))
+	assert.NoError(t, err)
+	assertTimeEquals(t, time.Date(1969, 12, 31, 23, 59, 59, 999000000, time.UTC), parsed)
+
+	_, err = jsonFormats[JSONFormatUnix].UnmarshalJSONTime([]byte(`"1534760430"`))
+	assert.Equal(t, ErrInvalidFormat, err)
+	_, err = LayoutJSONFormat("2006/01/02").UnmarshalJSONTime([]byte(`2018`))
+	assert.Equal(t, ErrInvalidFormat, err)
+	_, err = LookupJSONFormat("unknown")
+	assert.Equal(t, ErrUnknownFormat, err)
+}
+
+func TestDateJSONFormat(t *testing.T) {
+	t.Parallel()
+	src := NewDate(2018, 8, 20)
+	actual, err := json.Marshal(&src)
+	assert.NoError(t, err)
+	assert.Equal(t, `"2018-08-20"`, string(actual))
+
+	actual, err = json.Marshal(NewNullDate(src))
+	assert.NoError(t, err)
+	assert.Equal(t, `"2018-08-20"`, string(actual))
+
+	for _, data := range []string{`"2018-08-20"`, `"2018-08-20T00:00:00Z"`, `"2018-08-20T23:00:00-09:00"`} {
+		dst := Date{}
+		assert.NoError(t, json.Unmarshal([]byte(data), &dst), data)
+		assert.Equal(t, src, dst, data)
+	}
+
+	dst := Date{}
+	assert.Error(t, json.Unmarshal([]byte(`"2018/08/20"`), &dst))
+	assertOutOfRange(t, json.Unmarshal([]byte(`"0999-12-31"`), &dst))
+}
+
+func TestSetDateTimeJSONFormat(t *testing.T) {
+	defer SetDateTimeJSONFormat(JSONFormatRFC3339)
+	defer SetDateJSONFormat(JSONFormatDate)
+	assert.Equal(t, ErrUnknownFormat, SetDateTimeJSONFormat("unknown"))
+	assert.NoError(t, SetDateTimeJSONFormat(JSONFormatUnix))
+	assert.NoError(t, SetDateJSONFormat(JSONFormatUnix))
+
+	src := NewDateTime(2018, 8, 20, 10, 20, 30, 0, time.UTC)
+	actual, err := json.Marshal(src)
+	assert.NoError(t, err)
+	assert.Equal(t, `1534760430`, string(actual))
+
+	actual, err = json.Marshal(NewTimestampFromTime(src.Time()))
+	assert.NoError(t, err)
+	assert.Equal(t, `1534760430`, string(actual))
+
+	date := NewDate(2018, 8, 20)
+	actual, err = json.Marshal(&date)
+	assert.NoError(t, err)
+	assert.Equal(t, `1534723200`, string(actual))
+
+	for _, data := range []string{`1534760430`, `"2018-08-20T10:20:30Z"`} {
+		dst := DateTime{}
+		assert.NoError(t, json.Unmarshal([]byte(data), &dst), data)
+		assert.True(t, src.Equal(dst), data)
+	}
+}
+
+func TestRegisterJSONFormat(t *testing.T) {
+	t.Parallel()
+	RegisterJSONFormat("test-slash", LayoutJSONFormat("2006/01/02"))
+	f, err := LookupJSONFormat("test-slash")
+	assert.NoError(t, err)
+	actual, err := NewDate(2018, 8, 20).marshalJSONWith(f)
+	assert.NoError(t, err)
+	assert.Equal(t, `"2018/08/20"`, string(actual))
+}
@@ -0,0 +1,114 @@
+//go:build go1.18
+// +build go1.18
+
+package mysqltype
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+
+	"github.com/jinzhu/gorm"
+)
+
+// JSONOf support MySQL JSON type holding T such as struct, map or slice
+// NULL is represented as Valid == false and marshalled to JSON null, as NullDate.
+// Data is marshalled with encoding/json on Value and unmarshalled on Scan.
+// It requires Go 1.18, and JSON can be used with earlier versions.
+type JSONOf[T any] struct {
+	Data  T
+	Valid bool
+}
+
+// NewJSONOf Create new valid JSONOf
+func NewJSONOf[T any](data T) JSONOf[T] {
+	return JSONOf[T]{Data: data, Valid: true}
+}
+
+// NewJSONOfFromPtr Create new JSONOf from pointer, nil means NULL
+func NewJSONOfFromPtr[T any](data *T) JSONOf[T] {
+	if data == nil {
+		return JSONOf[T]{}
+	}
+	return NewJSONOf(*data)
+}
+
+// Ptr convert to pointer, NULL means nil
+func (j JSONOf[T]) Ptr() *T {
+	if !j.Valid {
+		return nil
+	}
+	data := j.Data
+	return &data
+}
+
+// JSON convert to raw JSON
+func (j JSONOf[T]) JSON() (JSON, error) {
+	if !j.Valid {
+		return JSON{}, nil
+	}
+	return NewJSON(j.Data)
+}
+
+// UnmarshalJSON JSON null means NULL
+func (j *JSONOf[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*j = JSONOf[T]{}
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*j = NewJSONOf(v)
+	return nil
+}
+
+// MarshalJSON NULL is marshalled to JSON null
+func (j JSONOf[T]) MarshalJSON() ([]byte, error) {
+	if !j.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(j.Data)
+}
+
+// GormDataType column type for gorm AutoMigrate
+func (JSONOf[T]) GormDataType(gorm.Dialect) string {
+	return "json"
+}
+
+var _ driver.Valuer = JSONOf[struct{}]{}
+var _ sql.Scanner = &JSONOf[struct{}]{}
+var _ json.Marshaler = JSONOf[struct{}]{}
+var _ json.Unmarshaler = &JSONOf[struct{}]{}
+
+// Scan for sql.Scanner
+// Data is reset before unmarshalling, so that no fields are left from the previous value.
+func (j *JSONOf[T]) Scan(value interface{}) error {
+	raw := JSON{}
+	if err := raw.Scan(value); err != nil {
+		if _, ok := err.(*InvalidValueTypeError); ok {
+			return &InvalidValueTypeError{Value: value, Target: "JSONOf"}
+		}
+		return err
+	}
+	if raw.IsNull() {
+		*j = JSONOf[T]{}
+		return nil
+	}
+	var v T
+	if err := raw.Unmarshal(&v); err != nil {
+		return err
+	}
+	*j = NewJSONOf(v)
+	return nil
+}
+
+// Value for driver.Valuer
+func (j JSONOf[T]) Value() (driver.Value, error) {
+	raw, err := j.JSON()
+	if err != nil {
+		return nil, err
+	}
+	return raw.Value()
+}
@@ -0,0 +1,96 @@
+//go:build go1.18
+// +build go1.18
+
+package mysqltype
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type jsonOfTestAttrs struct {
+	Color string   `json:"color"`
+	Tags  []string `json:"tags,omitempty"`
+}
+
+type JSONOfFieldTestStruct struct {
+	ID     int
+	Attrs  JSONOf[jsonOfTestAttrs]
+	Scores JSONOf[map[string]int]
+}
+
+func TestJSONOfField(t *testing.T) {
+	t.Parallel()
+	assert.NoError(t, DB.AutoMigrate(&JSONOfFieldTestStruct{}).Error)
+
+	null := &JSONOfFieldTestStruct{}
+	assert.NoError(t, DB.Create(null).Error)
+	dst := &JSONOfFieldTestStruct{ID: null.ID}
+	assert.NoError(t, DB.First(dst).Error)
+	assert.Equal(t, null, dst)
+
+	valid := &JSONOfFieldTestStruct{
+		Attrs:  NewJSONOf(jsonOfTestAttrs{Color: "red", Tags: []string{"a"}}),
+		Scores: NewJSONOf(map[string]int{"math": 90}),
+	}
+	assert.NoError(t, DB.Create(valid).Error)
+	dst = &JSONOfFieldTestStruct{ID: valid.ID}
+	assert.NoError(t, DB.First(dst).Error)
+	assert.Equal(t, valid, dst)
+}
+
+func TestJSONOfScan(t *testing.T) {
+	t.Parallel()
+	target := NewJSONOf(jsonOfTestAttrs{Color: "blue", Tags: []string{"b"}})
+	assert.NoError(t, target.Scan([]byte(`{"color": "red"}`)))
+	assert.Equal(t, NewJSONOf(jsonOfTestAttrs{Color: "red"}), target)
+	assert.NoError(t, target.Scan(`{"color": "green"}`))
+	assert.Equal(t, NewJSONOf(jsonOfTestAttrs{Color: "green"}), target)
+	assert.NoError(t, target.Scan(nil))
+	assert.False(t, target.Valid)
+	assert.Nil(t, target.Ptr())
+
+	assert.Equal(t, ErrInvalidFormat, target.Scan("{"))
+	assert.Error(t, target.Scan(`[1]`))
+	assertInvalidValueType(t, target.Scan(1.0))
+}
+
+func TestJSONOfValue(t *testing.T) {
+	t.Parallel()
+	v, err := JSONOf[[]int]{}.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	v, err = NewJSONOf([]int{1, 2}).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `[1,2]`, v)
+
+	_, err = NewJSONOf(map[string]interface{}{"f": func() {}}).Value()
+	assert.Error(t, err)
+}
+
+func TestJSONOfMarshalJSON(t *testing.T) {
+	t.Parallel()
+	type target struct {
+		Attrs JSONOf[jsonOfTestAttrs]
+		Null  JSONOf[[]int]
+	}
+	v := target{Attrs: NewJSONOf(jsonOfTestAttrs{Color: "red"})}
+	actual, err := json.Marshal(v)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Attrs":{"color":"red"},"Null":null}`, string(actual))
+
+	dst := target{Null: NewJSONOf([]int{1})}
+	assert.NoError(t, json.Unmarshal(actual, &dst))
+	assert.Equal(t, v, dst)
+}
+
+func TestNewJSONOfFromPtr(t *testing.T) {
+	t.Parallel()
+	assert.False(t, NewJSONOfFromPtr[int](nil).Valid)
+	v := 1
+	assert.Equal(t, NewJSONOf(1), NewJSONOfFromPtr(&v))
+	assert.Equal(t, &v, NewJSONOf(1).Ptr())
+}
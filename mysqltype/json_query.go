@@ -0,0 +1,278 @@
+package mysqltype
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/jinzhu/gorm"
+)
+
+// JSONPathQuery build conditions on a path in MySQL JSON column
+// Paths are validated and written into SQL as literals, so that MySQL can use
+// generated column, functional and multi-valued indexes on the same path,
+// and values are always passed as parameters.
+// Paths containing quotes, backslashes or question marks are rejected with ErrInvalidFormat.
+//
+//	db.Scopes(mysqltype.JSONQuery("attrs").Path("$.color").Equals("red").Scope()).Find(&items)
+type JSONPathQuery struct {
+	column string
+	path   string
+	err    error
+}
+
+// JSONQuery start JSONPathQuery on the root of column
+func JSONQuery(column string) JSONPathQuery {
+	return JSONPathQuery{column: column, path: "$"}
+}
+
+// Path replace the path by MySQL JSON path such as "$.color", "$.tags[0]" or "$.items[*].id"
+func (q JSONPathQuery) Path(path string) JSONPathQuery {
+	if q.err == nil && !isJSONPath(path) {
+		q.err = ErrInvalidFormat
+	}
+	q.path = path
+	return q
+}
+
+// Key append member of the object at the path
+func (q JSONPathQuery) Key(key string) JSONPathQuery {
+	switch {
+	case q.err != nil:
+	case isJSONPathIdentifier(key):
+		q.path += "." + key
+	case key != "" && !strings.ContainsAny(key, jsonPathForbidden):
+		q.path += `."` + key + `"`
+	default:
+		q.err = ErrInvalidFormat
+	}
+	return q
+}
+
+// Index append element of the array at the path
+func (q JSONPathQuery) Index(i int) JSONPathQuery {
+	if q.err == nil && i < 0 {
+		q.err = ErrInvalidFormat
+	}
+	q.path += "[" + strconv.Itoa(i) + "]"
+	return q
+}
+
+// String MySQL JSON path
+func (q JSONPathQuery) String() string {
+	return q.path
+}
+
+// Equals rows whose value at the path is the JSON of v
+// Values are compared as JSON, so that "1" does not equal 1.
+func (q JSONPathQuery) Equals(v interface{}) JSONCondition {
+	return q.jsonCondition(func(column string) string {
+		return q.extract(column) + " = CAST(? AS JSON)"
+	}, v)
+}
+
+// EqualsText rows whose unquoted value at the path is s
+// It uses indexes added by AddGeneratedColumn and AddFunctionalIndex on the path.
+func (q JSONPathQuery) EqualsText(s string) JSONCondition {
+	return q.condition(func(column string) string {
+		return q.unquote(column) + " = ?"
+	}, s)
+}
+
+// Contains rows whose value at the path contains the JSON of v as JSON_CONTAINS
+// It uses index added by AddMultiValuedIndex on the path.
+func (q JSONPathQuery) Contains(v interface{}) JSONCondition {
+	return q.jsonCondition(func(column string) string {
+		return "JSON_CONTAINS(" + q.extract(column) + ", ?)"
+	}, v)
+}
+
+// HasKey rows whose object at the path has key
+func (q JSONPathQuery) HasKey(key string) JSONCondition {
+	return q.Key(key).Exists()
+}
+
+// Exists rows which have any value at the path including JSON null
+func (q JSONPathQuery) Exists() JSONCondition {
+	return q.condition(func(column string) string {
+		return "JSON_CONTAINS_PATH(" + column + ", 'one', '" + q.path + "')"
+	})
+}
+
+// MemberOf rows whose array at the path has v as MEMBER OF of MySQL 8.0.17
+// v must be string or number. It uses index added by AddMultiValuedIndex on the path.
+func (q JSONPathQuery) MemberOf(v interface{}) JSONCondition {
+	return q.condition(func(column string) string {
+		return "? MEMBER OF(" + q.extract(column) + ")"
+	}, v)
+}
+
+// AddGeneratedColumn add virtual generated column of sqlType holding the unquoted value at the path
+// Index the column by AddIndex, and EqualsText on the path uses it.
+//
+//	mysqltype.JSONQuery("attrs").Path("$.color").AddGeneratedColumn(db.Model(&Item{}), "color", "VARCHAR(32)")
+func (q JSONPathQuery) AddGeneratedColumn(db *gorm.DB, name, sqlType string) *gorm.DB {
+	if q.err != nil {
+		return withError(db, q.err)
+	}
+	scope := db.NewScope(db.Value)
+	expr := q.unquote(scope.Quote(q.column))
+	return db.Exec("ALTER TABLE " + scope.QuotedTableName() + " ADD COLUMN " + scope.Quote(name) + " " + sqlType + " AS (" + expr + ") VIRTUAL")
+}
+
+// AddFunctionalIndex add functional index on the unquoted value at the path cast to sqlType of MySQL 8.0.13
+// CHAR types are collated by utf8mb4_bin, so that EqualsText on the path uses the index.
+func (q JSONPathQuery) AddFunctionalIndex(db *gorm.DB, indexName, sqlType string) *gorm.DB {
+	if q.err != nil {
+		return withError(db, q.err)
+	}
+	scope := db.NewScope(db.Value)
+	expr := "CAST(" + q.unquote(scope.Quote(q.column)) + " AS " + sqlType + ")"
+	if strings.HasPrefix(strings.ToUpper(sqlType), "CHAR") {
+		expr += " COLLATE utf8mb4_bin"
+	}
+	return db.Exec("CREATE INDEX " + scope.Quote(indexName) + " ON " + scope.QuotedTableName() + " ((" + expr + "))")
+}
+
+// AddMultiValuedIndex add multi-valued index on the array at the path of MySQL 8.0.17
+// sqlType is the type of elements such as "CHAR(32)" or "UNSIGNED", and MemberOf and Contains on the path use the index.
+func (q JSONPathQuery) AddMultiValuedIndex(db *gorm.DB, indexName, sqlType string) *gorm.DB {
+	if q.err != nil {
+		return withError(db, q.err)
+	}
+	scope := db.NewScope(db.Value)
+	expr := "CAST(" + q.extract(scope.Quote(q.column)) + " AS " + sqlType + " ARRAY)"
+	return db.Exec("CREATE INDEX " + scope.Quote(indexName) + " ON " + scope.QuotedTableName() + " ((" + expr + "))")
+}
+
+// extract JSON_EXTRACT on the path of quoted column
+// The path is written as it is, since it has no characters in jsonPathForbidden.
+func (q JSONPathQuery) extract(column string) string {
+	return "JSON_EXTRACT(" + column + ", '" + q.path + "')"
+}
+
+// unquote JSON_UNQUOTE on the path of quoted column
+func (q JSONPathQuery) unquote(column string) string {
+	return "JSON_UNQUOTE(" + q.extract(column) + ")"
+}
+
+func (q JSONPathQuery) condition(query func(column string) string, args ...interface{}) JSONCondition {
+	return JSONCondition{column: q.column, query: query, args: args, err: q.err}
+}
+
+// jsonCondition condition with v marshalled as JSON text
+func (q JSONPathQuery) jsonCondition(query func(column string) string, v interface{}) JSONCondition {
+	data, err := json.Marshal(v)
+	if err != nil {
+		c := q.condition(query)
+		if c.err == nil {
+			c.err = err
+		}
+		return c
+	}
+	return q.condition(query, string(data))
+}
+
+// JSONCondition condition on MySQL JSON column built by JSONPathQuery
+type JSONCondition struct {
+	column string
+	query  func(column string) string
+	args   []interface{}
+	err    error
+}
+
+// Not negate c
+func (c JSONCondition) Not() JSONCondition {
+	query := c.query
+	c.query = func(column string) string {
+		return "NOT (" + query(column) + ")"
+	}
+	return c
+}
+
+// Scope gorm scope to find rows matching c
+// The error of invalid path or value is added to a new clone of db, so that db is not affected.
+func (c JSONCondition) Scope() func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if c.err != nil {
+			return withError(db, c.err)
+		}
+		query, args := c.condition(db.NewScope(nil).Quote)
+		return db.Where(query, args...)
+	}
+}
+
+func (c JSONCondition) condition(quote func(string) string) (string, []interface{}) {
+	return c.query(quote(c.column)), c.args
+}
+
+// withError new clone of db with err
+// gorm.DB.AddError sets the error on db itself, which may be the root DB shared by every query.
+func withError(db *gorm.DB, err error) *gorm.DB {
+	db = db.New()
+	db.AddError(err)
+	return db
+}
+
+// jsonPathForbidden characters rejected in keys of JSONPathQuery
+// Quotes and backslashes need escaping in SQL literals, and gorm replaces every question mark by a parameter.
+const jsonPathForbidden = "\"'\\?"
+
+// isJSONPath reports whether path is MySQL JSON path without jsonPathForbidden in keys
+// https://dev.mysql.com/doc/refman/8.0/en/json.html#json-path-syntax
+func isJSONPath(path string) bool {
+	if !strings.HasPrefix(path, "$") {
+		return false
+	}
+	s := path[1:]
+	for s != "" {
+		switch {
+		case strings.HasPrefix(s, "**"):
+			s = s[2:]
+			if s == "" || strings.HasPrefix(s, "**") {
+				return false
+			}
+		case strings.HasPrefix(s, ".*"):
+			s = s[2:]
+		case strings.HasPrefix(s, `."`):
+			end := strings.IndexByte(s[2:], '"')
+			if end <= 0 || strings.ContainsAny(s[2:2+end], jsonPathForbidden) {
+				return false
+			}
+			s = s[3+end:]
+		case strings.HasPrefix(s, "."):
+			end := strings.IndexAny(s[1:], ".[*")
+			if end < 0 {
+				end = len(s) - 1
+			}
+			if !isJSONPathIdentifier(s[1 : 1+end]) {
+				return false
+			}
+			s = s[1+end:]
+		case strings.HasPrefix(s, "["):
+			end := strings.IndexByte(s, ']')
+			if end < 0 || (s[1:end] != "*" && !isDigits(s[1:end])) {
+				return false
+			}
+			s = s[end+1:]
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// isJSONPathIdentifier reports whether key can be written in JSON path without quotes
+func isJSONPathIdentifier(key string) bool {
+	if key == "" {
+		return false
+	}
+	for i, r := range key {
+		if r == '_' || r == '$' || unicode.IsLetter(r) || (i > 0 && unicode.IsDigit(r)) {
+			continue
+		}
+		return false
+	}
+	return true
+}
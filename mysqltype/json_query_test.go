@@ -0,0 +1,123 @@
+package mysqltype
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type JSONQueryFieldTestStruct struct {
+	ID    int
+	Attrs JSON
+}
+
+func TestJSONQueryField(t *testing.T) {
+	t.Parallel()
+	assert.NoError(t, DB.AutoMigrate(&JSONQueryFieldTestStruct{}).Error)
+	var ids []int
+	for _, attrs := range []string{
+		`{"color": "red", "size": 1, "tags": ["a", "b"]}`,
+		`{"color": "blue", "size": "1", "tags": ["b"]}`,
+		`{"color": null}`,
+	} {
+		v, err := NewJSONFromBytes([]byte(attrs))
+		assert.NoError(t, err)
+		target := &JSONQueryFieldTestStruct{Attrs: v}
+		assert.NoError(t, DB.Create(target).Error)
+		ids = append(ids, target.ID)
+	}
+
+	q := JSONQuery("attrs")
+	for _, tt := range []struct {
+		condition JSONCondition
+		expected  []int
+	}{
+		{q.Path("$.color").Equals("red"), ids[:1]},
+		{q.Key("size").Equals(1), ids[:1]},
+		{q.Key("size").Equals("1"), ids[1:2]},
+		{q.Key("color").EqualsText("blue"), ids[1:2]},
+		{q.Key("tags").Contains([]string{"b"}), ids[:2]},
+		{q.Key("tags").MemberOf("a"), ids[:1]},
+		{q.HasKey("color"), ids},
+		{q.HasKey("tags").Not(), ids[2:]},
+	} {
+		var actual []int
+		assert.NoError(t, DB.Model(&JSONQueryFieldTestStruct{}).Scopes(tt.condition.Scope()).Where("id in (?)", ids).Order("id").Pluck("id", &actual).Error)
+		assert.Equal(t, tt.expected, actual)
+	}
+
+	assert.Equal(t, ErrInvalidFormat, DB.Model(&JSONQueryFieldTestStruct{}).Scopes(q.Path("color").Equals("red").Scope()).Find(&[]JSONQueryFieldTestStruct{}).Error)
+
+	// errors must not remain in the global DB
+	assert.Equal(t, ErrInvalidFormat, DB.Scopes(q.Path("color").Equals("red").Scope()).Find(&[]JSONQueryFieldTestStruct{}).Error)
+	assert.Equal(t, ErrInvalidFormat, q.Path("color").AddFunctionalIndex(DB, "idx_color", "CHAR(32)").Error)
+	assert.NoError(t, DB.Error)
+	var count int
+	assert.NoError(t, DB.Model(&JSONQueryFieldTestStruct{}).Where("id in (?)", ids).Count(&count).Error)
+	assert.Equal(t, len(ids), count)
+}
+
+func TestJSONQueryCondition(t *testing.T) {
+	t.Parallel()
+	quote := func(s string) string { return "`" + s + "`" }
+	q := JSONQuery("attrs")
+
+	query, args := q.Path("$.color").Equals("red").condition(quote)
+	assert.Equal(t, "JSON_EXTRACT(`attrs`, '$.color') = CAST(? AS JSON)", query)
+	assert.Equal(t, []interface{}{`"red"`}, args)
+
+	query, args = q.Key("color").EqualsText("red").Not().condition(quote)
+	assert.Equal(t, "NOT (JSON_UNQUOTE(JSON_EXTRACT(`attrs`, '$.color')) = ?)", query)
+	assert.Equal(t, []interface{}{"red"}, args)
+
+	query, args = q.Key("tags").Contains([]int{1, 2}).condition(quote)
+	assert.Equal(t, "JSON_CONTAINS(JSON_EXTRACT(`attrs`, '$.tags'), ?)", query)
+	assert.Equal(t, []interface{}{"[1,2]"}, args)
+
+	query, args = q.Key("items").Index(0).HasKey("first name").condition(quote)
+	assert.Equal(t, "JSON_CONTAINS_PATH(`attrs`, 'one', '$.items[0].\"first name\"')", query)
+	assert.Empty(t, args)
+
+	query, args = q.Key("tags").MemberOf(1).condition(quote)
+	assert.Equal(t, "? MEMBER OF(JSON_EXTRACT(`attrs`, '$.tags'))", query)
+	assert.Equal(t, []interface{}{1}, args)
+
+	assert.Equal(t, ErrInvalidFormat, q.Key("it's").Equals(1).err)
+	assert.Equal(t, ErrInvalidFormat, q.Key("").Exists().err)
+	assert.Equal(t, ErrInvalidFormat, q.Index(-1).Exists().err)
+	assert.Error(t, q.Equals(func() {}).err)
+}
+
+func TestIsJSONPath(t *testing.T) {
+	t.Parallel()
+	for _, path := range []string{
+		"$",
+		"$.color",
+		"$.tags[0]",
+		"$.items[*].id",
+		"$.*",
+		"$**.id",
+		`$."first name"`,
+		`$."50%"[1]`,
+		"$.名前",
+	} {
+		assert.True(t, isJSONPath(path), path)
+	}
+	for _, path := range []string{
+		"",
+		"color",
+		"$.",
+		"$..a",
+		"$.1a",
+		"$[a]",
+		"$[]",
+		"$**",
+		"$.a b",
+		`$."it's"`,
+		`$."a\"b"`,
+		`$."a?"`,
+		"$.a') OR 1 = 1 -- ",
+	} {
+		assert.False(t, isJSONPath(path), path)
+	}
+}
@@ -0,0 +1,428 @@
+package mysqltype
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// MarshalJSON json.Marshal honoring mysqltype struct tags of Date, DateTime, Timestamp and their Null types
+// The tag selects a registered JSONFormat by name or a layout as time.Format, e.g.
+//
+//	CreatedAt mysqltype.DateTime `json:"created_at" mysqltype:"format=unix"`
+//	BirthDay  mysqltype.Date     `json:"birth_day" mysqltype:"layout=2006/01/02"`
+//
+// Tags are applied to exported fields of structs, including embedded and nested structs, pointers, slices and arrays,
+// but not to values in maps or interfaces.
+func MarshalJSON(v interface{}) ([]byte, error) {
+	return marshalJSONValue(reflect.ValueOf(v))
+}
+
+// UnmarshalJSON json.Unmarshal honoring mysqltype struct tags, see MarshalJSON
+func UnmarshalJSON(data []byte, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return json.Unmarshal(data, v)
+	}
+	return unmarshalJSONValue(data, rv.Elem())
+}
+
+// jsonTagField field with mysqltype tag or field containing them
+type jsonTagField struct {
+	index  []int
+	name   string
+	format JSONFormat
+}
+
+type jsonTagFields struct {
+	fields []jsonTagField
+	err    error
+}
+
+var (
+	hasJSONTagCache    sync.Map
+	jsonTagFieldsCache sync.Map
+)
+
+var (
+	jsonMarshalerType   = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+)
+
+// hasJSONTag reports whether values of t have any mysqltype tags to apply
+func hasJSONTag(t reflect.Type) bool {
+	if cached, ok := hasJSONTagCache.Load(t); ok {
+		return cached.(bool)
+	}
+	has := hasJSONTagVisiting(t, map[reflect.Type]bool{})
+	hasJSONTagCache.Store(t, has)
+	return has
+}
+
+func hasJSONTagVisiting(t reflect.Type, visiting map[reflect.Type]bool) bool {
+	if visiting[t] {
+		return false
+	}
+	visiting[t] = true
+	defer delete(visiting, t)
+
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array:
+		return hasJSONTagVisiting(t.Elem(), visiting)
+	case reflect.Struct:
+		if t.Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(jsonUnmarshalerType) {
+			return false
+		}
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.PkgPath != "" {
+				continue
+			}
+			if _, ok := f.Tag.Lookup("mysqltype"); ok || hasJSONTagVisiting(f.Type, visiting) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// jsonTagFieldsOf fields of struct type t to be marshalled by mysqltype
+func jsonTagFieldsOf(t reflect.Type) ([]jsonTagField, error) {
+	if cached, ok := jsonTagFieldsCache.Load(t); ok {
+		c := cached.(jsonTagFields)
+		return c.fields, c.err
+	}
+	var fields []jsonTagField
+	err := appendJSONTagFields(&fields, t, nil)
+	jsonTagFieldsCache.Store(t, jsonTagFields{fields: fields, err: err})
+	return fields, err
+}
+
+func appendJSONTagFields(fields *[]jsonTagField, t reflect.Type, index []int) error {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, ok := jsonFieldName(f)
+		if !ok || f.PkgPath != "" {
+			continue
+		}
+		fieldIndex := append(append([]int{}, index...), i)
+		ft := f.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if f.Anonymous && f.Tag.Get("json") == "" && ft.Kind() == reflect.Struct {
+			if err := appendJSONTagFields(fields, ft, fieldIndex); err != nil {
+				return err
+			}
+			continue
+		}
+		if tag, ok := f.Tag.Lookup("mysqltype"); ok {
+			format, err := parseJSONTag(tag)
+			if err != nil {
+				return fmt.Errorf("mysqltype: field %s: %v", f.Name, err)
+			}
+			*fields = append(*fields, jsonTagField{index: fieldIndex, name: name, format: format})
+		} else if hasJSONTag(f.Type) {
+			*fields = append(*fields, jsonTagField{index: fieldIndex, name: name})
+		}
+	}
+	return nil
+}
+
+// jsonFieldName key of f in JSON object as encoding/json
+func jsonFieldName(f reflect.StructField) (string, bool) {
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	if i := strings.Index(tag, ","); i >= 0 {
+		tag = tag[:i]
+	}
+	if tag != "" {
+		return tag, true
+	}
+	return f.Name, true
+}
+
+// parseJSONTag parse mysqltype tag "format=name" or "layout=layout"
+func parseJSONTag(tag string) (JSONFormat, error) {
+	switch {
+	case strings.HasPrefix(tag, "format="):
+		return LookupJSONFormat(strings.TrimPrefix(tag, "format="))
+	case strings.HasPrefix(tag, "layout="):
+		return LayoutJSONFormat(strings.TrimPrefix(tag, "layout=")), nil
+	}
+	return nil, ErrInvalidFormat
+}
+
+func marshalJSONValue(v reflect.Value) ([]byte, error) {
+	if !v.IsValid() || !hasJSONTag(v.Type()) {
+		return json.Marshal(interfaceOf(v))
+	}
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return []byte("null"), nil
+		}
+		return marshalJSONValue(v.Elem())
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return []byte("null"), nil
+		}
+		var buf bytes.Buffer
+		buf.WriteByte('[')
+		for i := 0; i < v.Len(); i++ {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			elem, err := marshalJSONValue(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(elem)
+		}
+		buf.WriteByte(']')
+		return buf.Bytes(), nil
+	}
+
+	fields, err := jsonTagFieldsOf(v.Type())
+	if err != nil {
+		return nil, err
+	}
+	base, err := json.Marshal(interfaceOf(v))
+	if err != nil {
+		return nil, err
+	}
+	overrides := map[string]json.RawMessage{}
+	for _, f := range fields {
+		fv, ok := fieldByIndex(v, f.index, false)
+		if !ok {
+			continue
+		}
+		var data []byte
+		if f.format != nil {
+			data, err = marshalJSONField(fv, f.format)
+		} else {
+			data, err = marshalJSONValue(fv)
+		}
+		if err != nil {
+			return nil, err
+		}
+		overrides[f.name] = data
+	}
+	return replaceJSONObject(base, overrides)
+}
+
+func unmarshalJSONValue(data []byte, v reflect.Value) error {
+	if !hasJSONTag(v.Type()) {
+		return json.Unmarshal(data, v.Addr().Interface())
+	}
+	switch v.Kind() {
+	case reflect.Ptr:
+		if string(data) == "null" {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return unmarshalJSONValue(data, v.Elem())
+	case reflect.Slice, reflect.Array:
+		if string(data) == "null" {
+			if v.Kind() == reflect.Slice {
+				v.Set(reflect.Zero(v.Type()))
+			}
+			return nil
+		}
+		var elems []json.RawMessage
+		if err := json.Unmarshal(data, &elems); err != nil {
+			return err
+		}
+		if v.Kind() == reflect.Slice {
+			v.Set(reflect.MakeSlice(v.Type(), len(elems), len(elems)))
+		}
+		for i := 0; i < len(elems) && i < v.Len(); i++ {
+			if err := unmarshalJSONValue(elems[i], v.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	fields, err := jsonTagFieldsOf(v.Type())
+	if err != nil {
+		return err
+	}
+	if string(data) == "null" {
+		return nil
+	}
+	members, err := splitJSONObject(data)
+	if err != nil {
+		return err
+	}
+	var rest []jsonMember
+	var tagged []func() error
+	for _, m := range members {
+		f, ok := findJSONTagField(fields, m.key)
+		if !ok {
+			rest = append(rest, m)
+			continue
+		}
+		value := m.value
+		tagged = append(tagged, func() error {
+			fv, _ := fieldByIndex(v, f.index, true)
+			if f.format != nil {
+				return unmarshalJSONField(value, fv, f.format)
+			}
+			return unmarshalJSONValue(value, fv)
+		})
+	}
+	if err := json.Unmarshal(joinJSONObject(rest), v.Addr().Interface()); err != nil {
+		return err
+	}
+	for _, f := range tagged {
+		if err := f(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// marshalJSONField marshal v of field with mysqltype tag
+func marshalJSONField(v reflect.Value, f JSONFormat) ([]byte, error) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return []byte("null"), nil
+		}
+		v = v.Elem()
+	}
+	m, ok := v.Interface().(jsonFormatMarshaler)
+	if !ok {
+		return nil, fmt.Errorf("mysqltype: format tag is not supported for %s", v.Type())
+	}
+	return m.marshalJSONWith(f)
+}
+
+// unmarshalJSONField unmarshal data into v of field with mysqltype tag
+func unmarshalJSONField(data []byte, v reflect.Value, f JSONFormat) error {
+	if v.Kind() == reflect.Ptr {
+		if string(data) == "null" {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+	u, ok := v.Addr().Interface().(jsonFormatUnmarshaler)
+	if !ok {
+		return fmt.Errorf("mysqltype: format tag is not supported for %s", v.Type())
+	}
+	return u.unmarshalJSONWith(data, f)
+}
+
+// fieldByIndex nested field of v by index
+// Nil embedded pointers are allocated if alloc is true, otherwise it reports false.
+func fieldByIndex(v reflect.Value, index []int, alloc bool) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if !alloc {
+					return reflect.Value{}, false
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
+
+// findJSONTagField field for key, preferring an exact match over a case-insensitive one as encoding/json
+func findJSONTagField(fields []jsonTagField, key string) (jsonTagField, bool) {
+	for _, f := range fields {
+		if f.name == key {
+			return f, true
+		}
+	}
+	for _, f := range fields {
+		if strings.EqualFold(f.name, key) {
+			return f, true
+		}
+	}
+	return jsonTagField{}, false
+}
+
+type jsonMember struct {
+	key   string
+	value json.RawMessage
+}
+
+// splitJSONObject members of JSON object in order
+func splitJSONObject(data []byte) ([]jsonMember, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil {
+		return nil, err
+	} else if tok != json.Delim('{') {
+		return nil, ErrInvalidFormat
+	}
+	var members []jsonMember
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, _ := tok.(string)
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, err
+		}
+		members = append(members, jsonMember{key: key, value: value})
+	}
+	return members, nil
+}
+
+// joinJSONObject JSON object of members in order
+func joinJSONObject(members []jsonMember) []byte {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, m := range members {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(m.key)
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(m.value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes()
+}
+
+// replaceJSONObject replace values of members in JSON object data by overrides
+// Keys missing in data, e.g. by omitempty, are not added.
+func replaceJSONObject(data []byte, overrides map[string]json.RawMessage) ([]byte, error) {
+	members, err := splitJSONObject(data)
+	if err != nil {
+		return nil, err
+	}
+	for i, m := range members {
+		if value, ok := overrides[m.key]; ok {
+			members[i].value = value
+		}
+	}
+	return joinJSONObject(members), nil
+}
+
+// interfaceOf v as interface{}, or nil if v is invalid
+func interfaceOf(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+	return v.Interface()
+}
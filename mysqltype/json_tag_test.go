@@ -0,0 +1,105 @@
+package mysqltype
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type JSONTagTestEmbedded struct {
+	UpdatedAt DateTime `json:"updated_at" mysqltype:"format=unixmilli"`
+}
+
+type JSONTagTestItem struct {
+	Due NullDate `json:"due" mysqltype:"layout=01/02/2006"`
+}
+
+type JSONTagTestStruct struct {
+	JSONTagTestEmbedded
+	ID        int               `json:"id"`
+	CreatedAt DateTime          `json:"created_at" mysqltype:"format=unix"`
+	BirthDay  Date              `json:"birth_day" mysqltype:"format=datetime"`
+	DeletedAt *DateTime         `json:"deleted_at,omitempty" mysqltype:"format=unix"`
+	Plain     DateTime          `json:"plain"`
+	Items     []JSONTagTestItem `json:"items"`
+	Ignored   DateTime          `json:"-" mysqltype:"format=unix"`
+}
+
+func TestMarshalJSON(t *testing.T) {
+	t.Parallel()
+	src := JSONTagTestStruct{
+		JSONTagTestEmbedded: JSONTagTestEmbedded{UpdatedAt: NewDateTime(2018, 8, 20, 10, 20, 30, 123000000, time.UTC)},
+		ID:                  1,
+		CreatedAt:           NewDateTime(2018, 8, 20, 10, 20, 30, 0, time.UTC),
+		BirthDay:            NewDate(1990, 1, 2),
+		Plain:               NewDateTime(2018, 8, 20, 10, 20, 30, 0, time.UTC),
+		Items:               []JSONTagTestItem{{Due: NewNullDate(NewDate(2018, 9, 30))}, {}},
+	}
+	expected := `{"updated_at":1534760430123,"id":1,"created_at":1534760430,"birth_day":"1990-01-02 00:00:00",` +
+		`"plain":"2018-08-20T10:20:30Z","items":[{"due":"09/30/2018"},{"due":null}]}`
+	actual, err := MarshalJSON(src)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, string(actual))
+
+	actual, err = MarshalJSON(&src)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, string(actual))
+
+	dst := JSONTagTestStruct{}
+	assert.NoError(t, UnmarshalJSON(actual, &dst))
+	assert.Equal(t, 1, dst.ID)
+	assert.True(t, src.UpdatedAt.Equal(dst.UpdatedAt))
+	assert.True(t, src.CreatedAt.Equal(dst.CreatedAt))
+	assert.True(t, src.BirthDay.Equal(dst.BirthDay))
+	assert.True(t, src.Plain.Equal(dst.Plain))
+	assert.Nil(t, dst.DeletedAt)
+	if assert.Len(t, dst.Items, 2) {
+		assert.True(t, src.Items[0].Due.Equal(dst.Items[0].Due))
+		assert.False(t, dst.Items[1].Due.Valid)
+	}
+
+	deletedAt := NewDateTime(2018, 8, 21, 0, 0, 0, 0, time.UTC)
+	src.DeletedAt = &deletedAt
+	actual, err = MarshalJSON([]JSONTagTestStruct{src})
+	assert.NoError(t, err)
+	assert.Contains(t, string(actual), `"deleted_at":1534809600`)
+
+	var dsts []*JSONTagTestStruct
+	assert.NoError(t, UnmarshalJSON(actual, &dsts))
+	if assert.Len(t, dsts, 1) && assert.NotNil(t, dsts[0].DeletedAt) {
+		assert.True(t, deletedAt.Equal(*dsts[0].DeletedAt))
+	}
+}
+
+func TestMarshalJSONWithoutTag(t *testing.T) {
+	t.Parallel()
+	src := map[string]interface{}{"date": NewNullDate(NewDate(2018, 8, 20))}
+	actual, err := MarshalJSON(src)
+	assert.NoError(t, err)
+	expected, err := json.Marshal(src)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	actual, err = MarshalJSON(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "null", string(actual))
+}
+
+func TestMarshalJSONInvalidTag(t *testing.T) {
+	t.Parallel()
+	type unknown struct {
+		At DateTime `mysqltype:"format=unknown"`
+	}
+	_, err := MarshalJSON(unknown{})
+	assert.Error(t, err)
+	assert.Error(t, UnmarshalJSON([]byte(`{}`), &unknown{}))
+
+	type unsupported struct {
+		At time.Time `mysqltype:"format=unix"`
+	}
+	_, err = MarshalJSON(unsupported{})
+	assert.Error(t, err)
+	assert.Error(t, UnmarshalJSON([]byte(`{"At":1}`), &unsupported{}))
+}
@@ -0,0 +1,113 @@
+package mysqltype
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type JSONFieldTestStruct struct {
+	ID     int
+	Target JSON
+}
+
+func TestJSONField(t *testing.T) {
+	t.Parallel()
+	assert.NoError(t, DB.AutoMigrate(&JSONFieldTestStruct{}).Error)
+
+	null := &JSONFieldTestStruct{}
+	assert.NoError(t, DB.Create(null).Error)
+	dst := &JSONFieldTestStruct{ID: null.ID}
+	assert.NoError(t, DB.First(dst).Error)
+	assert.True(t, dst.Target.IsNull())
+
+	v, err := NewJSON(map[string]interface{}{"color": "red", "sizes": []int{1, 2}})
+	assert.NoError(t, err)
+	target := &JSONFieldTestStruct{Target: v}
+	assert.NoError(t, DB.Create(target).Error)
+	dst = &JSONFieldTestStruct{ID: target.ID}
+	assert.NoError(t, DB.First(dst).Error)
+	actual := map[string]interface{}{}
+	assert.NoError(t, dst.Target.Unmarshal(&actual))
+	assert.Equal(t, map[string]interface{}{"color": "red", "sizes": []interface{}{1.0, 2.0}}, actual)
+}
+
+func TestNewJSONFromBytes(t *testing.T) {
+	t.Parallel()
+	data := []byte(`{"a": 1}`)
+	v, err := NewJSONFromBytes(data)
+	assert.NoError(t, err)
+	data[2] = 'b'
+	assert.Equal(t, `{"a": 1}`, v.String())
+
+	_, err = NewJSONFromBytes([]byte(`{"a": }`))
+	assert.Equal(t, ErrInvalidFormat, err)
+	_, err = NewJSONFromBytes(nil)
+	assert.Equal(t, ErrInvalidFormat, err)
+}
+
+func TestJSONScan(t *testing.T) {
+	t.Parallel()
+	target := JSON{}
+	assert.NoError(t, target.Scan([]byte(`[1, 2]`)))
+	assert.Equal(t, `[1, 2]`, target.String())
+	assert.NoError(t, target.Scan(`"text"`))
+	assert.Equal(t, `"text"`, target.String())
+	assert.NoError(t, target.Scan("null"))
+	assert.False(t, target.IsNull())
+	assert.NoError(t, target.Scan(nil))
+	assert.True(t, target.IsNull())
+
+	assert.Equal(t, ErrInvalidFormat, target.Scan("{"))
+	assertInvalidValueType(t, target.Scan(int64(1)))
+}
+
+func TestJSONValue(t *testing.T) {
+	t.Parallel()
+	v, err := NullJSON().Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	target, err := NewJSON([]string{"a"})
+	assert.NoError(t, err)
+	v, err = target.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `["a"]`, v)
+
+	_, err = JSON{src: []byte("{")}.Value()
+	assert.Equal(t, ErrInvalidFormat, err)
+}
+
+func TestJSONMarshalJSON(t *testing.T) {
+	t.Parallel()
+	type target struct {
+		Attrs JSON
+		Null  JSON
+	}
+	attrs, err := NewJSON(map[string]int{"a": 1})
+	assert.NoError(t, err)
+	actual, err := json.Marshal(target{Attrs: attrs})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Attrs":{"a":1},"Null":null}`, string(actual))
+
+	dst := target{Null: attrs}
+	assert.NoError(t, json.Unmarshal([]byte(`{"Attrs": {"a": [1, 2]}, "Null": null}`), &dst))
+	assert.Equal(t, `{"a": [1, 2]}`, dst.Attrs.String())
+	assert.True(t, dst.Null.IsNull())
+}
+
+func TestJSONEqual(t *testing.T) {
+	t.Parallel()
+	a, err := NewJSONFromBytes([]byte(`{"a": [1, 2]}`))
+	assert.NoError(t, err)
+	b, err := NewJSONFromBytes([]byte(`{"a":[1,2]}`))
+	assert.NoError(t, err)
+	c, err := NewJSONFromBytes([]byte(`null`))
+	assert.NoError(t, err)
+
+	assert.True(t, a.Equal(b))
+	assert.False(t, a.Equal(c))
+	assert.False(t, c.Equal(NullJSON()))
+	assert.True(t, NullJSON().Equal(JSON{}))
+}
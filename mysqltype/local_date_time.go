@@ -0,0 +1,314 @@
+package mysqltype
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+// LocalDateTime support MySQL DateTime type as wall clock without time zone
+// https://dev.mysql.com/doc/refman/8.0/en/datetime.html
+// Scan and Value never convert time zones, so stored values do not drift across DST or server TZ changes.
+// It holds the number of days since 0001-01-01 and nanoseconds of the day as Date does,
+// so no location can be held even through reflection or gob.
+// Its marshallers fail out of DATETIME range, the same as its unmarshallers.
+type LocalDateTime struct {
+	days int32
+	nsec int64
+}
+
+// NewLocalDateTime Create new LocalDateTime from wall clock
+// Out of range values are normalized as time.Date.
+func NewLocalDateTime(year int, month time.Month, day, hour, min, sec, nsec int) LocalDateTime {
+	return NewLocalDateTimeFromTime(time.Date(year, month, day, hour, min, sec, nsec, time.UTC))
+}
+
+// NewLocalDateTimeFromTime Create new LocalDateTime from wall clock of Time in its location
+func NewLocalDateTimeFromTime(t time.Time) LocalDateTime {
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	return LocalDateTime{
+		days: int32(daysFromCivil(year, month, day)),
+		nsec: int64(hour)*int64(time.Hour) + int64(min)*int64(time.Minute) + int64(sec)*int64(time.Second) + int64(t.Nanosecond()),
+	}
+}
+
+// MinLocalDateTime Minimum LocalDateTime
+func MinLocalDateTime() LocalDateTime {
+	return NewLocalDateTime(1000, 1, 1, 0, 0, 0, 0)
+}
+
+// MaxLocalDateTime Maximum LocalDateTime
+func MaxLocalDateTime() LocalDateTime {
+	return NewLocalDateTime(9999, 12, 31, 23, 59, 59, 999999000)
+}
+
+// NowLocalDateTime Create Now wall clock in loc
+func NowLocalDateTime(loc *time.Location) LocalDateTime {
+	return NewLocalDateTimeFromTime(time.Now().In(loc))
+}
+
+// AtLocation DateTime of the wall clock in loc
+func (ldt LocalDateTime) AtLocation(loc *time.Location) DateTime {
+	year, month, day := ldt.Date()
+	hour, min, sec := ldt.Clock()
+	return NewDateTime(year, month, day, hour, min, sec, ldt.Nanosecond(), loc)
+}
+
+// After reports whether ldt is after u in wall clock
+func (ldt LocalDateTime) After(u LocalDateTime) bool {
+	return ldt.days > u.days || (ldt.days == u.days && ldt.nsec > u.nsec)
+}
+
+// Before reports whether ldt is before u in wall clock
+func (ldt LocalDateTime) Before(u LocalDateTime) bool {
+	return ldt.days < u.days || (ldt.days == u.days && ldt.nsec < u.nsec)
+}
+
+// Equal reports whether ldt and u are same wall clock
+func (ldt LocalDateTime) Equal(u LocalDateTime) bool {
+	return ldt == u
+}
+
+// AddDate behavior as time.Time
+func (ldt LocalDateTime) AddDate(years int, months int, days int) LocalDateTime {
+	return NewLocalDateTimeFromTime(ldt.wallClock().AddDate(years, months, days))
+}
+
+// Sub difference of wall clock, ignoring any DST transitions
+func (ldt LocalDateTime) Sub(u LocalDateTime) time.Duration {
+	return ldt.wallClock().Sub(u.wallClock())
+}
+
+// Add moves wall clock by d, ignoring any DST transitions
+func (ldt LocalDateTime) Add(d time.Duration) LocalDateTime {
+	return NewLocalDateTimeFromTime(ldt.wallClock().Add(d))
+}
+
+// Truncate behavior as time.Time
+func (ldt LocalDateTime) Truncate(d time.Duration) LocalDateTime {
+	return NewLocalDateTimeFromTime(ldt.wallClock().Truncate(d))
+}
+
+// Round behavior as time.Time
+func (ldt LocalDateTime) Round(d time.Duration) LocalDateTime {
+	return NewLocalDateTimeFromTime(ldt.wallClock().Round(d))
+}
+
+// IsZero behavior as time.Time
+func (ldt LocalDateTime) IsZero() bool {
+	return ldt.days == 0 && ldt.nsec == 0
+}
+
+// IsValid reports whether ldt is in MySQL DATETIME range
+func (ldt LocalDateTime) IsValid() bool {
+	return !ldt.Before(MinLocalDateTime()) && !ldt.After(MaxLocalDateTime())
+}
+
+func (ldt LocalDateTime) validate() error {
+	if !ldt.IsValid() {
+		return &OutOfRangeError{Value: ldt, Min: MinLocalDateTime(), Max: MaxLocalDateTime()}
+	}
+	return nil
+}
+
+// Date behavior as time.Time
+func (ldt LocalDateTime) Date() (year int, month time.Month, day int) {
+	return civilFromDays(int64(ldt.days))
+}
+
+// Year behavior as time.Time
+func (ldt LocalDateTime) Year() int {
+	return Date{days: ldt.days}.Year()
+}
+
+// Month behavior as time.Time
+func (ldt LocalDateTime) Month() time.Month {
+	return Date{days: ldt.days}.Month()
+}
+
+// Day behavior as time.Time
+func (ldt LocalDateTime) Day() int {
+	return Date{days: ldt.days}.Day()
+}
+
+// Weekday behavior as time.Time
+func (ldt LocalDateTime) Weekday() time.Weekday {
+	return Date{days: ldt.days}.Weekday()
+}
+
+// YearDay behavior as time.Time
+func (ldt LocalDateTime) YearDay() int {
+	return Date{days: ldt.days}.YearDay()
+}
+
+// Clock behavior as time.Time
+func (ldt LocalDateTime) Clock() (hour, min, sec int) {
+	sec = int(ldt.nsec / int64(time.Second))
+	return sec / 3600, sec / 60 % 60, sec % 60
+}
+
+// Hour behavior as time.Time
+func (ldt LocalDateTime) Hour() int {
+	return int(ldt.nsec / int64(time.Hour))
+}
+
+// Minute behavior as time.Time
+func (ldt LocalDateTime) Minute() int {
+	return int(ldt.nsec / int64(time.Minute) % 60)
+}
+
+// Second behavior as time.Time
+func (ldt LocalDateTime) Second() int {
+	return int(ldt.nsec / int64(time.Second) % 60)
+}
+
+// Nanosecond behavior as time.Time
+func (ldt LocalDateTime) Nanosecond() int {
+	return int(ldt.nsec % int64(time.Second))
+}
+
+// wallClock time.Time of the wall clock in UTC
+func (ldt LocalDateTime) wallClock() time.Time {
+	year, month, day := ldt.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Add(time.Duration(ldt.nsec))
+}
+
+// String format as MySQL DATETIME literal
+func (ldt LocalDateTime) String() string {
+	return ldt.wallClock().Format(dateTimeFormatLayout)
+}
+
+// UnmarshalText parse ISO 8601 date and time without time zone
+func (ldt *LocalDateTime) UnmarshalText(text []byte) error {
+	t, err := parseTimeText(string(text), localDateTimeLayouts)
+	if err != nil {
+		return err
+	}
+	dst := NewLocalDateTimeFromTime(t)
+	if err := dst.validate(); err != nil {
+		return err
+	}
+	*ldt = dst
+	return nil
+}
+
+// MarshalText format as ISO 8601 date and time without time zone
+func (ldt LocalDateTime) MarshalText() ([]byte, error) {
+	if err := ldt.validate(); err != nil {
+		return nil, err
+	}
+	return []byte(ldt.wallClock().Format(localDateTimeTextLayout)), nil
+}
+
+// UnmarshalBinary behavior as time.Time, taking the wall clock in its location
+func (ldt *LocalDateTime) UnmarshalBinary(data []byte) error {
+	t := time.Time{}
+	if err := t.UnmarshalBinary(data); err != nil {
+		return err
+	}
+	dst := NewLocalDateTimeFromTime(t)
+	if err := dst.validate(); err != nil {
+		return err
+	}
+	*ldt = dst
+	return nil
+}
+
+// MarshalBinary behavior as time.Time of the wall clock in UTC
+func (ldt LocalDateTime) MarshalBinary() ([]byte, error) {
+	if err := ldt.validate(); err != nil {
+		return nil, err
+	}
+	return ldt.wallClock().MarshalBinary()
+}
+
+// GobEncode encode as MarshalBinary
+func (ldt LocalDateTime) GobEncode() ([]byte, error) {
+	return ldt.MarshalBinary()
+}
+
+// GobDecode decode as UnmarshalBinary
+func (ldt *LocalDateTime) GobDecode(data []byte) error {
+	return ldt.UnmarshalBinary(data)
+}
+
+// UnmarshalJSON parse ISO 8601 date and time without time zone in JSON string
+func (ldt *LocalDateTime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	s, err := strconv.Unquote(string(data))
+	if err != nil {
+		return ErrInvalidFormat
+	}
+	return ldt.UnmarshalText([]byte(s))
+}
+
+// MarshalJSON format as ISO 8601 date and time without time zone in JSON string
+func (ldt LocalDateTime) MarshalJSON() ([]byte, error) {
+	text, err := ldt.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(strconv.Quote(string(text))), nil
+}
+
+// GormDataType column type for gorm AutoMigrate
+func (LocalDateTime) GormDataType(gorm.Dialect) string {
+	return "datetime(6)"
+}
+
+const localDateTimeTextLayout = "2006-01-02T15:04:05.999999999"
+
+// localDateTimeLayouts textual layouts without time zone accepted by LocalDateTime
+var localDateTimeLayouts = []string{
+	dateTimeFormatLayout,
+	localDateTimeTextLayout,
+	"2006-01-02 15:04",
+	"2006-01-02T15:04",
+	"20060102150405",
+	dateFormatLayout,
+}
+
+var _ driver.Valuer = LocalDateTime{}
+var _ sql.Scanner = &LocalDateTime{}
+var _ encoding.TextUnmarshaler = &LocalDateTime{}
+var _ encoding.TextMarshaler = LocalDateTime{}
+var _ encoding.BinaryMarshaler = LocalDateTime{}
+var _ encoding.BinaryUnmarshaler = &LocalDateTime{}
+var _ json.Marshaler = LocalDateTime{}
+var _ json.Unmarshaler = &LocalDateTime{}
+
+// Scan for sql.Scanner
+// time.Time from driver is used as wall clock in its location without conversion.
+func (ldt *LocalDateTime) Scan(value interface{}) error {
+	var t time.Time
+	switch v := value.(type) {
+	case time.Time:
+		t = v
+	case []byte, string:
+		var err error
+		if t, err = scanTime(v, "LocalDateTime", localDateTimeLayouts); err != nil {
+			return err
+		}
+	default:
+		return &InvalidValueTypeError{Value: value, Target: "LocalDateTime"}
+	}
+	*ldt = NewLocalDateTimeFromTime(t)
+	return nil
+}
+
+// Value for driver.Valuer
+// It returns text instead of time.Time, so that the driver does not convert time zones.
+func (ldt LocalDateTime) Value() (driver.Value, error) {
+	if err := ldt.validate(); err != nil {
+		return nil, err
+	}
+	return ldt.wallClock().Format(dateTimeFormatLayout), nil
+}
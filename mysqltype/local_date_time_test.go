@@ -0,0 +1,167 @@
+package mysqltype
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type LocalDateTimeFieldTestStruct struct {
+	ID         int
+	TargetDate LocalDateTime `gorm:"not null"`
+}
+
+type LocalDateTimeNullFieldTestStruct struct {
+	ID         int
+	TargetDate *LocalDateTime
+}
+
+func TestLocalDateTimeField(t *testing.T) {
+	t.Parallel()
+	target := &LocalDateTimeFieldTestStruct{
+		TargetDate: NewLocalDateTime(2024, 3, 10, 2, 30, 0, 123456000),
+	}
+	assert.NoError(t, DB.AutoMigrate(target).Error)
+	assert.NoError(t, DB.Create(target).Error)
+
+	dummy := &LocalDateTimeNullFieldTestStruct{}
+
+	assertMySQLErrNumber(t, DB.Table("local_date_time_field_test_structs").Create(&dummy).Error, mySQLNullError)
+	dst := &LocalDateTimeFieldTestStruct{
+		ID: target.ID,
+	}
+	assert.NoError(t, DB.First(dst).Error)
+	assert.Equal(t, target.TargetDate, dst.TargetDate)
+
+	var stored string
+	assert.NoError(t, DB.Raw("select cast(target_date as char) from local_date_time_field_test_structs where id = ?", target.ID).Row().Scan(&stored))
+	assert.Equal(t, "2024-03-10 02:30:00.123456", stored)
+
+	assert.NoError(t, DB.Save(&LocalDateTimeFieldTestStruct{TargetDate: MaxLocalDateTime()}).Error)
+	assert.NoError(t, DB.Save(&LocalDateTimeFieldTestStruct{TargetDate: MinLocalDateTime()}).Error)
+}
+
+func TestLocalDateTimeValue(t *testing.T) {
+	asiaTokyo, err := time.LoadLocation("Asia/Tokyo")
+	assert.NoError(t, err)
+	defer SetLocation(nil)
+	SetLocation(asiaTokyo)
+
+	v, err := NewLocalDateTime(2024, 3, 10, 9, 0, 0, 0).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-03-10 09:00:00", v)
+
+	_, err = LocalDateTime{}.Value()
+	assertOutOfRange(t, err)
+}
+
+func TestLocalDateTimeScan(t *testing.T) {
+	asiaTokyo, err := time.LoadLocation("Asia/Tokyo")
+	assert.NoError(t, err)
+	defer SetLocation(nil)
+	SetLocation(asiaTokyo)
+
+	expected := NewLocalDateTime(2024, 3, 10, 9, 0, 0, 0)
+	target := LocalDateTime{}
+	assert.NoError(t, target.Scan(time.Date(2024, 3, 10, 9, 0, 0, 0, asiaTokyo)))
+	assert.Equal(t, expected, target)
+	assert.NoError(t, target.Scan([]byte("2024-03-10 09:00:00")))
+	assert.Equal(t, expected, target)
+	assert.NoError(t, target.Scan("2024-03-10T09:00"))
+	assert.Equal(t, expected, target)
+	assert.Error(t, target.Scan("2024-03-10T09:00:00+09:00"))
+	assertInvalidValueType(t, target.Scan(int64(1710028800)))
+}
+
+func TestLocalDateTimeAtLocation(t *testing.T) {
+	t.Parallel()
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+	v := NewLocalDateTime(2024, 3, 10, 9, 0, 0, 0)
+
+	dt := v.AtLocation(newYork)
+	assert.Equal(t, newYork, dt.Location())
+	assert.Equal(t, 9, dt.Hour())
+	assertTimeEquals(t, time.Date(2024, 3, 10, 13, 0, 0, 0, time.UTC), dt.Time())
+
+	dt = v.AddDate(0, 0, -1).AtLocation(newYork)
+	assertTimeEquals(t, time.Date(2024, 3, 9, 14, 0, 0, 0, time.UTC), dt.Time())
+}
+
+func TestNewLocalDateTimeFromTime(t *testing.T) {
+	t.Parallel()
+	asiaTokyo, err := time.LoadLocation("Asia/Tokyo")
+	assert.NoError(t, err)
+	v := NewLocalDateTimeFromTime(time.Date(2024, 3, 10, 9, 0, 0, 5, asiaTokyo))
+	assert.Equal(t, NewLocalDateTime(2024, 3, 10, 9, 0, 0, 5), v)
+}
+
+func TestLocalDateTimeMarshalJSON(t *testing.T) {
+	t.Parallel()
+	v := NewLocalDateTime(2024, 3, 10, 9, 0, 0, 500000000)
+	actual, err := json.Marshal(v)
+	assert.NoError(t, err)
+	assert.Equal(t, `"2024-03-10T09:00:00.5"`, string(actual))
+
+	dst := LocalDateTime{}
+	assert.NoError(t, json.Unmarshal(actual, &dst))
+	assert.Equal(t, v, dst)
+	assertOutOfRange(t, json.Unmarshal([]byte(`"0999-12-31T00:00:00"`), &dst))
+}
+
+func TestLocalDateTimeMarshalBinary(t *testing.T) {
+	t.Parallel()
+	v := NewLocalDateTime(2024, 3, 10, 9, 0, 0, 500000000)
+	data, err := v.MarshalBinary()
+	assert.NoError(t, err)
+	dst := LocalDateTime{}
+	assert.NoError(t, dst.UnmarshalBinary(data))
+	assert.Equal(t, v, dst)
+
+	data, err = time.Date(2024, 3, 10, 9, 0, 0, 0, time.FixedZone("JST", 9*60*60)).MarshalBinary()
+	assert.NoError(t, err)
+	assert.NoError(t, dst.UnmarshalBinary(data))
+	assert.Equal(t, NewLocalDateTime(2024, 3, 10, 9, 0, 0, 0), dst)
+}
+
+func TestLocalDateTimeArithmetic(t *testing.T) {
+	t.Parallel()
+	v1 := NewLocalDateTime(2024, 3, 10, 1, 0, 0, 0)
+	v2 := NewLocalDateTime(2024, 3, 10, 3, 0, 0, 0)
+
+	assert.Equal(t, 2*time.Hour, v2.Sub(v1))
+	assert.Equal(t, v2, v1.Add(2*time.Hour))
+	assert.True(t, v2.After(v1))
+	assert.True(t, v1.Before(v2))
+	assert.True(t, v1.Equal(v2.Add(-2*time.Hour)))
+	assert.Equal(t, NewLocalDateTime(2024, 3, 10, 0, 0, 0, 0), v1.Truncate(24*time.Hour))
+}
+
+func TestLocalDateTimeClock(t *testing.T) {
+	t.Parallel()
+	max := MaxLocalDateTime()
+	year, month, day := max.Date()
+	hour, min, sec := max.Clock()
+	assert.Equal(t, []int{9999, 12, 31, 23, 59, 59, 999999000}, []int{year, int(month), day, hour, min, sec, max.Nanosecond()})
+	assert.Equal(t, []int{9999, 12, 31, 23, 59, 59}, []int{max.Year(), int(max.Month()), max.Day(), max.Hour(), max.Minute(), max.Second()})
+	assert.Equal(t, time.Friday, max.Weekday())
+	assert.Equal(t, 365, max.YearDay())
+}
+
+func TestLocalDateTimeGob(t *testing.T) {
+	t.Parallel()
+	asiaTokyo, err := time.LoadLocation("Asia/Tokyo")
+	assert.NoError(t, err)
+	v := NewLocalDateTimeFromTime(time.Date(2024, 3, 10, 9, 0, 0, 5, asiaTokyo))
+
+	buf := bytes.Buffer{}
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(v))
+	dst := LocalDateTime{}
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(&dst))
+	assert.Equal(t, v, dst)
+	assert.Equal(t, "2024-03-10 09:00:00.000000005", dst.String())
+}
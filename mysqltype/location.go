@@ -0,0 +1,16 @@
+package mysqltype
+
+import "time"
+
+var location = time.UTC
+
+// SetLocation set location used to parse DATETIME and TIMESTAMP text in Scan
+// and to normalize DateTime in Value, which checks DATETIME range on the wall clock in it.
+// It should be the same as loc parameter of DSN, which is UTC by default.
+// It is not safe to call concurrently with Scan or Value, so call it on initialization.
+func SetLocation(loc *time.Location) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	location = loc
+}
@@ -0,0 +1,133 @@
+package mysqltype
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+)
+
+type LocationFieldTestStruct struct {
+	ID         int
+	TargetDate DateTime `gorm:"not null"`
+	TargetDay  Date     `gorm:"not null"`
+}
+
+func TestLocationField(t *testing.T) {
+	defer SetLocation(time.UTC)
+	for _, name := range []string{"America/New_York", "Asia/Tokyo"} {
+		loc, err := time.LoadLocation(name)
+		assert.NoError(t, err)
+		SetLocation(loc)
+
+		for _, parseTime := range []bool{true, false} {
+			db, err := gorm.Open("mysql", fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?loc=%s&parseTime=%t",
+				user, password, host, port, databaseName, url.QueryEscape(loc.String()), parseTime))
+			assert.NoError(t, err)
+			assert.NoError(t, db.AutoMigrate(&LocationFieldTestStruct{}).Error)
+
+			target := &LocationFieldTestStruct{
+				TargetDate: NewDateTime(2018, 8, 20, 1, 0, 0, 0, time.UTC),
+				TargetDay:  NewDate(2018, 8, 20),
+			}
+			assert.NoError(t, db.Create(target).Error)
+			dst := &LocationFieldTestStruct{ID: target.ID}
+			assert.NoError(t, db.First(dst).Error)
+			assertTimeEquals(t, target.TargetDate.src, dst.TargetDate.src)
+			assert.Equal(t, loc, dst.TargetDate.Location(), "loc: %s, parseTime: %t", loc, parseTime)
+			assert.Equal(t, target.TargetDay, dst.TargetDay, "loc: %s, parseTime: %t", loc, parseTime)
+
+			var storedDate, storedDay string
+			assert.NoError(t, db.Raw("select cast(target_date as char), cast(target_day as char) from location_field_test_structs where id = ?", target.ID).
+				Row().Scan(&storedDate, &storedDay))
+			assert.Equal(t, target.TargetDate.src.In(loc).Format("2006-01-02 15:04:05"), storedDate, "loc: %s, parseTime: %t", loc, parseTime)
+			assert.Equal(t, "2018-08-20", storedDay, "loc: %s, parseTime: %t", loc, parseTime)
+			assert.NoError(t, db.Close())
+		}
+	}
+}
+
+func TestLocationScan(t *testing.T) {
+	asiaTokyo, err := time.LoadLocation("Asia/Tokyo")
+	assert.NoError(t, err)
+	defer SetLocation(nil)
+	SetLocation(asiaTokyo)
+
+	dt := DateTime{}
+	assert.NoError(t, dt.Scan([]byte("2018-08-20 10:00:00")))
+	assert.Equal(t, asiaTokyo, dt.Location())
+	assertTimeEquals(t, time.Date(2018, 8, 20, 1, 0, 0, 0, time.UTC), dt.src)
+
+	assert.NoError(t, dt.Scan(time.Date(2018, 8, 20, 1, 0, 0, 0, time.UTC)))
+	assert.Equal(t, asiaTokyo, dt.Location())
+	assert.Equal(t, 10, dt.Hour())
+
+	ts := Timestamp{}
+	assert.NoError(t, ts.Scan("2018-08-20 10:00:00"))
+	assert.Equal(t, time.UTC, ts.Location())
+	assert.Equal(t, 1, ts.Hour())
+
+	d := Date{}
+	assert.NoError(t, d.Scan(int64(1534777200)))
+	assert.Equal(t, NewDate(2018, 8, 21), d)
+	assert.NoError(t, d.Scan(time.Date(2018, 8, 20, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, NewDate(2018, 8, 20), d)
+}
+
+func TestLocationValue(t *testing.T) {
+	asiaTokyo, err := time.LoadLocation("Asia/Tokyo")
+	assert.NoError(t, err)
+	defer SetLocation(nil)
+	SetLocation(asiaTokyo)
+
+	v, err := NewDateTime(2018, 8, 20, 1, 0, 0, 0, time.UTC).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2018, 8, 20, 10, 0, 0, 0, asiaTokyo), v)
+
+	v, err = NewDateTime3(NewDateTime(2018, 8, 20, 1, 0, 0, 0, time.UTC)).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2018, 8, 20, 10, 0, 0, 0, asiaTokyo), v)
+}
+
+func TestLocationDateValue(t *testing.T) {
+	defer SetLocation(nil)
+	for _, name := range []string{"America/New_York", "Asia/Tokyo"} {
+		loc, err := time.LoadLocation(name)
+		assert.NoError(t, err)
+		SetLocation(loc)
+
+		v, err := NewDate(2018, 8, 20).Value()
+		assert.NoError(t, err)
+		assert.Equal(t, "2018-08-20", v, name)
+	}
+}
+
+func TestLocationIsValid(t *testing.T) {
+	defer SetLocation(nil)
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+	asiaTokyo, err := time.LoadLocation("Asia/Tokyo")
+	assert.NoError(t, err)
+
+	min := NewDateTime(1000, 1, 1, 0, 0, 0, 0, time.UTC)
+	max := NewDateTime(9999, 12, 31, 23, 0, 0, 0, time.UTC)
+
+	SetLocation(newYork)
+	assert.False(t, min.IsValid())
+	assert.True(t, max.IsValid())
+	_, err = min.Value()
+	assertOutOfRange(t, err)
+	assert.True(t, MinDateTime().IsValid())
+	assert.True(t, MaxDateTime().IsValid())
+
+	SetLocation(asiaTokyo)
+	assert.True(t, min.IsValid())
+	assert.False(t, max.IsValid())
+	_, err = max.Value()
+	assertOutOfRange(t, err)
+	assert.True(t, MinDateTime().IsValid())
+	assert.True(t, MaxDateTime().IsValid())
+}
@@ -0,0 +1,165 @@
+package mysqltype
+
+import (
+	"math/big"
+)
+
+// Money amount of money in ISO 4217 currency
+// gorm embeds it into two columns of the amount and the currency, so give each field a prefix:
+//
+//	Price mysqltype.Money `gorm:"embedded;embedded_prefix:price_"`
+//
+// The amount column is decimal(19,4) and the currency column is char(3).
+// Amount keeps digits finer than the minor unit, e.g. of unit prices, until Round.
+// Arithmetic on different currencies returns ErrCurrencyMismatch.
+type Money struct {
+	Amount   Decimal  `gorm:"type:decimal(19,4);not null" json:"amount"`
+	Currency Currency `gorm:"type:char(3);not null" json:"currency"`
+}
+
+// NewMoney Create new Money of amount in currency
+func NewMoney(amount Decimal, currency Currency) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// NewMoneyFromMinor Create new Money of minor units, e.g. 1050 of USD is 10.50 USD
+// It returns ErrUnknownCurrency for the zero Currency.
+func NewMoneyFromMinor(minor int64, currency Currency) (Money, error) {
+	units, err := currency.MinorUnits()
+	if err != nil {
+		return Money{}, err
+	}
+	return NewMoney(NewDecimal(minor, int32(units)), currency), nil
+}
+
+// IsZero reports whether the amount of m is 0
+func (m Money) IsZero() bool {
+	return m.Amount.IsZero()
+}
+
+// Sign -1 if m < 0, 0 if m == 0 and +1 if m > 0
+func (m Money) Sign() int {
+	return m.Amount.Sign()
+}
+
+// Equal reports whether m and u are same amount in same currency regardless of the scale
+func (m Money) Equal(u Money) bool {
+	return m.Currency == u.Currency && m.Amount.Equal(u.Amount)
+}
+
+// Cmp -1 if m < u, 0 if m == u and +1 if m > u
+// It returns ErrCurrencyMismatch if currencies differ.
+func (m Money) Cmp(u Money) (int, error) {
+	if m.Currency != u.Currency {
+		return 0, ErrCurrencyMismatch
+	}
+	return m.Amount.Cmp(u.Amount), nil
+}
+
+// Neg returns -m
+func (m Money) Neg() Money {
+	return NewMoney(m.Amount.Neg(), m.Currency)
+}
+
+// Add returns m + u
+// It returns ErrCurrencyMismatch if currencies differ.
+func (m Money) Add(u Money) (Money, error) {
+	if m.Currency != u.Currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+	return NewMoney(m.Amount.Add(u.Amount), m.Currency), nil
+}
+
+// Sub returns m - u
+// It returns ErrCurrencyMismatch if currencies differ.
+func (m Money) Sub(u Money) (Money, error) {
+	if m.Currency != u.Currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+	return NewMoney(m.Amount.Sub(u.Amount), m.Currency), nil
+}
+
+// Mul returns m * factor exactly, so Round it to the minor unit if needed
+func (m Money) Mul(factor Decimal) Money {
+	return NewMoney(m.Amount.Mul(factor), m.Currency)
+}
+
+// Round returns m rounded to the minor unit of the currency by mode, e.g. 2 digits for USD
+// It returns ErrUnknownCurrency for the zero Currency.
+func (m Money) Round(mode RoundingMode) (Money, error) {
+	units, err := m.Currency.MinorUnits()
+	if err != nil {
+		return Money{}, err
+	}
+	return NewMoney(m.Amount.Round(units, mode), m.Currency), nil
+}
+
+// Allocate split m in proportion to ratios without losing the remainder
+// Parts are in the minor unit, or in the scale of m if it is finer, and sum up to m exactly.
+// The remainder is given one unit at a time to the parts from the first, skipping ratios of 0,
+// e.g. 0.05 USD allocated by 1:1 is 0.03 USD and 0.02 USD.
+// It returns ErrOutOfRange for negative ratios, ErrDivisionByZero if ratios sum up to 0
+// and ErrUnknownCurrency for the zero Currency.
+func (m Money) Allocate(ratios ...int64) ([]Money, error) {
+	units, err := m.Currency.MinorUnits()
+	if err != nil {
+		return nil, err
+	}
+	total := new(big.Int)
+	for _, r := range ratios {
+		if r < 0 {
+			return nil, ErrOutOfRange
+		}
+		total.Add(total, big.NewInt(r))
+	}
+	if total.Sign() == 0 {
+		return nil, ErrDivisionByZero
+	}
+	if m.Amount.Scale() > units {
+		units = m.Amount.Scale()
+	}
+	amount := m.Amount.Round(units, RoundDown).int()
+
+	shares := make([]*big.Int, len(ratios))
+	remainder := new(big.Int).Set(amount)
+	for i, r := range ratios {
+		shares[i] = new(big.Int).Mul(amount, big.NewInt(r))
+		shares[i].Quo(shares[i], total)
+		remainder.Sub(remainder, shares[i])
+	}
+	unit := big.NewInt(int64(remainder.Sign()))
+	for i := 0; remainder.Sign() != 0; i++ {
+		if ratios[i] == 0 {
+			continue
+		}
+		shares[i].Add(shares[i], unit)
+		remainder.Sub(remainder, unit)
+	}
+
+	parts := make([]Money, len(ratios))
+	for i, share := range shares {
+		parts[i] = NewMoney(newDecimal(share, int32(units)), m.Currency)
+	}
+	return parts, nil
+}
+
+// Split split m into n parts as equal as possible without losing the remainder as Allocate
+// It returns ErrDivisionByZero if n < 1.
+func (m Money) Split(n int) ([]Money, error) {
+	if n < 1 {
+		return nil, ErrDivisionByZero
+	}
+	ratios := make([]int64, n)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+	return m.Allocate(ratios...)
+}
+
+// String format as amount and currency code, e.g. "10.50 USD"
+func (m Money) String() string {
+	if m.Currency.IsZero() {
+		return m.Amount.String()
+	}
+	return m.Amount.String() + " " + m.Currency.String()
+}
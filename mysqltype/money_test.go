@@ -0,0 +1,172 @@
+package mysqltype
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type MoneyFieldTestStruct struct {
+	ID    int
+	Price Money `gorm:"embedded;embedded_prefix:price_"`
+	Tax   Money `gorm:"embedded;embedded_prefix:tax_"`
+}
+
+func TestMoneyField(t *testing.T) {
+	t.Parallel()
+	assert.NoError(t, DB.AutoMigrate(&MoneyFieldTestStruct{}).Error)
+	scope := DB.NewScope(&MoneyFieldTestStruct{})
+	for _, column := range []string{"price_amount", "price_currency", "tax_amount", "tax_currency"} {
+		assert.True(t, scope.Dialect().HasColumn(scope.TableName(), column), column)
+	}
+
+	target := &MoneyFieldTestStruct{
+		Price: mustMoney(t, "1234.5678", "USD"),
+		Tax:   mustMoney(t, "100", "JPY"),
+	}
+	assert.NoError(t, DB.Create(target).Error)
+	dst := &MoneyFieldTestStruct{ID: target.ID}
+	assert.NoError(t, DB.First(dst).Error)
+	assert.True(t, target.Price.Equal(dst.Price))
+	assert.True(t, target.Tax.Equal(dst.Tax))
+
+	err := DB.Create(&MoneyFieldTestStruct{Price: target.Price}).Error
+	assert.Truef(t, errors.Is(err, ErrUnknownCurrency), "unexpected error: `%v`", err)
+}
+
+func TestNewMoneyFromMinor(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		minor    int64
+		currency string
+		expected string
+	}{
+		{1050, "USD", "10.50 USD"},
+		{1050, "JPY", "1050 JPY"},
+		{-1050, "KWD", "-1.050 KWD"},
+	} {
+		m, err := NewMoneyFromMinor(tt.minor, mustCurrency(t, tt.currency))
+		assert.NoError(t, err)
+		assert.Equal(t, tt.expected, m.String())
+	}
+	_, err := NewMoneyFromMinor(1, Currency{})
+	assert.Equal(t, ErrUnknownCurrency, err)
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+	t.Parallel()
+	a := mustMoney(t, "10.50", "USD")
+	b := mustMoney(t, "0.25", "USD")
+
+	v, err := a.Add(b)
+	assert.NoError(t, err)
+	assert.Equal(t, "10.75 USD", v.String())
+	v, err = a.Sub(b)
+	assert.NoError(t, err)
+	assert.Equal(t, "10.25 USD", v.String())
+	assert.Equal(t, "-10.50 USD", a.Neg().String())
+	assert.Equal(t, "1.1550 USD", a.Mul(mustDecimal(t, "0.11")).String())
+	c, err := b.Cmp(a)
+	assert.NoError(t, err)
+	assert.Equal(t, -1, c)
+	assert.True(t, a.Equal(mustMoney(t, "10.5", "USD")))
+	assert.False(t, a.Equal(mustMoney(t, "10.50", "EUR")))
+
+	yen := mustMoney(t, "100", "JPY")
+	_, err = a.Add(yen)
+	assert.Equal(t, ErrCurrencyMismatch, err)
+	_, err = a.Sub(yen)
+	assert.Equal(t, ErrCurrencyMismatch, err)
+	_, err = a.Cmp(yen)
+	assert.Equal(t, ErrCurrencyMismatch, err)
+}
+
+func TestMoneyRound(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		amount   string
+		currency string
+		expected string
+	}{
+		{"1.005", "USD", "1.01 USD"},
+		{"1.5", "JPY", "2 JPY"},
+		{"1.0005", "KWD", "1.001 KWD"},
+		{"1", "USD", "1.00 USD"},
+	} {
+		v, err := mustMoney(t, tt.amount, tt.currency).Round(RoundHalfUp)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.expected, v.String())
+	}
+	v, err := mustMoney(t, "2.345", "EUR").Round(RoundHalfEven)
+	assert.NoError(t, err)
+	assert.Equal(t, "2.34 EUR", v.String())
+	_, err = NewMoney(NewDecimalFromInt(1), Currency{}).Round(RoundHalfUp)
+	assert.Equal(t, ErrUnknownCurrency, err)
+}
+
+func TestMoneyAllocate(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		amount   string
+		currency string
+		ratios   []int64
+		expected []string
+	}{
+		{"0.05", "USD", []int64{1, 1}, []string{"0.03 USD", "0.02 USD"}},
+		{"0.05", "USD", []int64{3, 7}, []string{"0.02 USD", "0.03 USD"}},
+		{"100", "JPY", []int64{1, 1, 1}, []string{"34 JPY", "33 JPY", "33 JPY"}},
+		{"-100", "JPY", []int64{1, 1, 1}, []string{"-34 JPY", "-33 JPY", "-33 JPY"}},
+		{"1", "KWD", []int64{0, 1, 1, 1}, []string{"0.000 KWD", "0.334 KWD", "0.333 KWD", "0.333 KWD"}},
+		{"0.0001", "USD", []int64{1, 1}, []string{"0.0001 USD", "0.0000 USD"}},
+	} {
+		m := mustMoney(t, tt.amount, tt.currency)
+		parts, err := m.Allocate(tt.ratios...)
+		assert.NoError(t, err)
+		var actual []string
+		sum := NewMoney(Decimal{}, m.Currency)
+		for _, p := range parts {
+			actual = append(actual, p.String())
+			sum, err = sum.Add(p)
+			assert.NoError(t, err)
+		}
+		assert.Equal(t, tt.expected, actual)
+		assert.True(t, m.Equal(sum), "%s is not %s", sum, m)
+	}
+
+	m := mustMoney(t, "1", "USD")
+	_, err := m.Allocate(1, -1)
+	assert.Equal(t, ErrOutOfRange, err)
+	_, err = m.Allocate(0, 0)
+	assert.Equal(t, ErrDivisionByZero, err)
+	_, err = m.Allocate()
+	assert.Equal(t, ErrDivisionByZero, err)
+	_, err = NewMoney(NewDecimalFromInt(1), Currency{}).Allocate(1)
+	assert.Equal(t, ErrUnknownCurrency, err)
+}
+
+func TestMoneySplit(t *testing.T) {
+	t.Parallel()
+	parts, err := mustMoney(t, "10", "USD").Split(3)
+	assert.NoError(t, err)
+	assert.Equal(t, []Money{mustMoney(t, "3.34", "USD"), mustMoney(t, "3.33", "USD"), mustMoney(t, "3.33", "USD")}, parts)
+	_, err = mustMoney(t, "10", "USD").Split(0)
+	assert.Equal(t, ErrDivisionByZero, err)
+}
+
+func TestMoneyMarshalJSON(t *testing.T) {
+	t.Parallel()
+	actual, err := json.Marshal(mustMoney(t, "10.50", "USD"))
+	assert.NoError(t, err)
+	assert.Equal(t, `{"amount":"10.50","currency":"USD"}`, string(actual))
+
+	dst := Money{}
+	assert.NoError(t, json.Unmarshal([]byte(`{"amount":1.5,"currency":"JPY"}`), &dst))
+	assert.Equal(t, "1.5 JPY", dst.String())
+	assert.Equal(t, ErrUnknownCurrency, json.Unmarshal([]byte(`{"amount":"1","currency":"ABC"}`), &dst))
+}
+
+func mustMoney(t *testing.T, amount, currency string) Money {
+	return NewMoney(mustDecimal(t, amount), mustCurrency(t, currency))
+}
@@ -0,0 +1,579 @@
+package mysqltype
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+
+	"github.com/jinzhu/gorm"
+)
+
+// scanNull scan value with scan unless it is NULL, and set valid
+func scanNull(value interface{}, valid *bool, scan func(interface{}) error) error {
+	if value == nil {
+		return nil
+	}
+	if err := scan(value); err != nil {
+		return err
+	}
+	*valid = true
+	return nil
+}
+
+// valueNull NULL unless valid, otherwise the result of value
+func valueNull(valid bool, value func() (driver.Value, error)) (driver.Value, error) {
+	if !valid {
+		return nil, nil
+	}
+	return value()
+}
+
+// unmarshalJSONNull unmarshal data with unmarshal unless it is JSON null, and set valid
+func unmarshalJSONNull(data []byte, valid *bool, unmarshal func([]byte) error) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if err := unmarshal(data); err != nil {
+		return err
+	}
+	*valid = true
+	return nil
+}
+
+// marshalJSONNull JSON null unless valid, otherwise the result of marshal
+func marshalJSONNull(valid bool, marshal func() ([]byte, error)) ([]byte, error) {
+	if !valid {
+		return []byte("null"), nil
+	}
+	return marshal()
+}
+
+// defaultDataType column type declared by the gorm tag on the first field of v
+// gorm takes it for fields of the type of v without type tags.
+func defaultDataType(v interface{}) string {
+	return tagType(reflect.TypeOf(v).Field(0).Tag)
+}
+
+// NullDate nullable Date with sql.Null* semantics
+// NULL is represented as Valid == false and marshalled to JSON null.
+type NullDate struct {
+	Date  Date
+	Valid bool
+}
+
+// NewNullDate Create new valid NullDate
+func NewNullDate(d Date) NullDate {
+	return NullDate{Date: d, Valid: true}
+}
+
+// NewNullDateFromPtr Create new NullDate from pointer, nil means NULL
+func NewNullDateFromPtr(d *Date) NullDate {
+	if d == nil {
+		return NullDate{}
+	}
+	return NewNullDate(*d)
+}
+
+// Ptr convert to pointer, NULL means nil
+func (n NullDate) Ptr() *Date {
+	if !n.Valid {
+		return nil
+	}
+	d := n.Date
+	return &d
+}
+
+// Equal reports whether both are NULL or both have same Date
+func (n NullDate) Equal(u NullDate) bool {
+	if !n.Valid || !u.Valid {
+		return n.Valid == u.Valid
+	}
+	return n.Date.Equal(u.Date)
+}
+
+// UnmarshalJSON JSON null means NULL
+func (n *NullDate) UnmarshalJSON(data []byte) error {
+	return n.unmarshalJSONWith(data, dateJSONFormat)
+}
+
+// MarshalJSON NULL is marshalled to JSON null
+func (n NullDate) MarshalJSON() ([]byte, error) {
+	return n.marshalJSONWith(dateJSONFormat)
+}
+
+func (n *NullDate) unmarshalJSONWith(data []byte, f JSONFormat) error {
+	*n = NullDate{}
+	return unmarshalJSONNull(data, &n.Valid, func(data []byte) error {
+		return n.Date.unmarshalJSONWith(data, f)
+	})
+}
+
+func (n NullDate) marshalJSONWith(f JSONFormat) ([]byte, error) {
+	return marshalJSONNull(n.Valid, func() ([]byte, error) {
+		return n.Date.marshalJSONWith(f)
+	})
+}
+
+// GormDataType column type for gorm AutoMigrate
+func (n NullDate) GormDataType(dialect gorm.Dialect) string {
+	return n.Date.GormDataType(dialect)
+}
+
+var _ driver.Valuer = NullDate{}
+var _ sql.Scanner = &NullDate{}
+var _ json.Marshaler = NullDate{}
+var _ json.Unmarshaler = &NullDate{}
+
+// Scan for sql.Scanner
+// MySQL zero date is NULL if SetZeroDateMode(ZeroDateNull) is set.
+func (n *NullDate) Scan(value interface{}) error {
+	*n = NullDate{}
+	if zeroDateMode == ZeroDateNull && isMySQLZeroValue(value) {
+		return nil
+	}
+	return scanNull(value, &n.Valid, n.Date.Scan)
+}
+
+// Value for driver.Valuer
+func (n NullDate) Value() (driver.Value, error) {
+	return valueNull(n.Valid, n.Date.Value)
+}
+
+// NullDateTime nullable DateTime with sql.Null* semantics
+// NULL is represented as Valid == false and marshalled to JSON null.
+type NullDateTime struct {
+	DateTime DateTime
+	Valid    bool
+}
+
+// NewNullDateTime Create new valid NullDateTime
+func NewNullDateTime(dt DateTime) NullDateTime {
+	return NullDateTime{DateTime: dt, Valid: true}
+}
+
+// NewNullDateTimeFromPtr Create new NullDateTime from pointer, nil means NULL
+func NewNullDateTimeFromPtr(dt *DateTime) NullDateTime {
+	if dt == nil {
+		return NullDateTime{}
+	}
+	return NewNullDateTime(*dt)
+}
+
+// Ptr convert to pointer, NULL means nil
+func (n NullDateTime) Ptr() *DateTime {
+	if !n.Valid {
+		return nil
+	}
+	dt := n.DateTime
+	return &dt
+}
+
+// Equal reports whether both are NULL or both have same DateTime
+func (n NullDateTime) Equal(u NullDateTime) bool {
+	if !n.Valid || !u.Valid {
+		return n.Valid == u.Valid
+	}
+	return n.DateTime.Equal(u.DateTime)
+}
+
+// UnmarshalJSON JSON null means NULL
+func (n *NullDateTime) UnmarshalJSON(data []byte) error {
+	return n.unmarshalJSONWith(data, dateTimeJSONFormat)
+}
+
+// MarshalJSON NULL is marshalled to JSON null
+func (n NullDateTime) MarshalJSON() ([]byte, error) {
+	return n.marshalJSONWith(dateTimeJSONFormat)
+}
+
+func (n *NullDateTime) unmarshalJSONWith(data []byte, f JSONFormat) error {
+	*n = NullDateTime{}
+	return unmarshalJSONNull(data, &n.Valid, func(data []byte) error {
+		return n.DateTime.unmarshalJSONWith(data, f)
+	})
+}
+
+func (n NullDateTime) marshalJSONWith(f JSONFormat) ([]byte, error) {
+	return marshalJSONNull(n.Valid, func() ([]byte, error) {
+		return n.DateTime.marshalJSONWith(f)
+	})
+}
+
+// GormDataType column type for gorm AutoMigrate, the default type of DateTime
+func (n NullDateTime) GormDataType(gorm.Dialect) string {
+	return defaultDataType(n.DateTime)
+}
+
+var _ driver.Valuer = NullDateTime{}
+var _ sql.Scanner = &NullDateTime{}
+var _ json.Marshaler = NullDateTime{}
+var _ json.Unmarshaler = &NullDateTime{}
+
+// Scan for sql.Scanner
+// MySQL zero date is NULL if SetZeroDateMode(ZeroDateNull) is set.
+func (n *NullDateTime) Scan(value interface{}) error {
+	*n = NullDateTime{}
+	if zeroDateMode == ZeroDateNull && isMySQLZeroValue(value) {
+		return nil
+	}
+	return scanNull(value, &n.Valid, n.DateTime.Scan)
+}
+
+// Value for driver.Valuer
+func (n NullDateTime) Value() (driver.Value, error) {
+	return valueNull(n.Valid, n.DateTime.Value)
+}
+
+// NullTime nullable Time with sql.Null* semantics
+// NULL is represented as Valid == false and marshalled to JSON null.
+type NullTime struct {
+	Time  Time
+	Valid bool
+}
+
+// NewNullTime Create new valid NullTime
+func NewNullTime(tm Time) NullTime {
+	return NullTime{Time: tm, Valid: true}
+}
+
+// NewNullTimeFromPtr Create new NullTime from pointer, nil means NULL
+func NewNullTimeFromPtr(tm *Time) NullTime {
+	if tm == nil {
+		return NullTime{}
+	}
+	return NewNullTime(*tm)
+}
+
+// Ptr convert to pointer, NULL means nil
+func (n NullTime) Ptr() *Time {
+	if !n.Valid {
+		return nil
+	}
+	tm := n.Time
+	return &tm
+}
+
+// Equal reports whether both are NULL or both have same Time
+func (n NullTime) Equal(u NullTime) bool {
+	if !n.Valid || !u.Valid {
+		return n.Valid == u.Valid
+	}
+	return n.Time.Equal(u.Time)
+}
+
+// UnmarshalJSON JSON null means NULL
+func (n *NullTime) UnmarshalJSON(data []byte) error {
+	*n = NullTime{}
+	return unmarshalJSONNull(data, &n.Valid, n.Time.UnmarshalJSON)
+}
+
+// MarshalJSON NULL is marshalled to JSON null
+func (n NullTime) MarshalJSON() ([]byte, error) {
+	return marshalJSONNull(n.Valid, n.Time.MarshalJSON)
+}
+
+// GormDataType column type for gorm AutoMigrate
+func (n NullTime) GormDataType(dialect gorm.Dialect) string {
+	return n.Time.GormDataType(dialect)
+}
+
+var _ driver.Valuer = NullTime{}
+var _ sql.Scanner = &NullTime{}
+var _ json.Marshaler = NullTime{}
+var _ json.Unmarshaler = &NullTime{}
+
+// Scan for sql.Scanner
+func (n *NullTime) Scan(value interface{}) error {
+	*n = NullTime{}
+	return scanNull(value, &n.Valid, n.Time.Scan)
+}
+
+// Value for driver.Valuer
+func (n NullTime) Value() (driver.Value, error) {
+	return valueNull(n.Valid, n.Time.Value)
+}
+
+// NullYear nullable Year with sql.Null* semantics
+// NULL is represented as Valid == false and marshalled to JSON null.
+type NullYear struct {
+	Year  Year
+	Valid bool
+}
+
+// NewNullYear Create new valid NullYear
+func NewNullYear(y Year) NullYear {
+	return NullYear{Year: y, Valid: true}
+}
+
+// NewNullYearFromPtr Create new NullYear from pointer, nil means NULL
+func NewNullYearFromPtr(y *Year) NullYear {
+	if y == nil {
+		return NullYear{}
+	}
+	return NewNullYear(*y)
+}
+
+// Ptr convert to pointer, NULL means nil
+func (n NullYear) Ptr() *Year {
+	if !n.Valid {
+		return nil
+	}
+	y := n.Year
+	return &y
+}
+
+// Equal reports whether both are NULL or both have same Year
+func (n NullYear) Equal(u NullYear) bool {
+	if !n.Valid || !u.Valid {
+		return n.Valid == u.Valid
+	}
+	return n.Year.Equal(u.Year)
+}
+
+// UnmarshalJSON JSON null means NULL
+func (n *NullYear) UnmarshalJSON(data []byte) error {
+	*n = NullYear{}
+	return unmarshalJSONNull(data, &n.Valid, n.Year.UnmarshalJSON)
+}
+
+// MarshalJSON NULL is marshalled to JSON null
+func (n NullYear) MarshalJSON() ([]byte, error) {
+	return marshalJSONNull(n.Valid, n.Year.MarshalJSON)
+}
+
+// GormDataType column type for gorm AutoMigrate
+func (n NullYear) GormDataType(dialect gorm.Dialect) string {
+	return n.Year.GormDataType(dialect)
+}
+
+var _ driver.Valuer = NullYear{}
+var _ sql.Scanner = &NullYear{}
+var _ json.Marshaler = NullYear{}
+var _ json.Unmarshaler = &NullYear{}
+
+// Scan for sql.Scanner
+func (n *NullYear) Scan(value interface{}) error {
+	*n = NullYear{}
+	return scanNull(value, &n.Valid, n.Year.Scan)
+}
+
+// Value for driver.Valuer
+func (n NullYear) Value() (driver.Value, error) {
+	return valueNull(n.Valid, n.Year.Value)
+}
+
+// NullTimestamp nullable Timestamp with sql.Null* semantics
+// NULL is represented as Valid == false and marshalled to JSON null.
+type NullTimestamp struct {
+	Timestamp Timestamp
+	Valid     bool
+}
+
+// NewNullTimestamp Create new valid NullTimestamp
+func NewNullTimestamp(ts Timestamp) NullTimestamp {
+	return NullTimestamp{Timestamp: ts, Valid: true}
+}
+
+// NewNullTimestampFromPtr Create new NullTimestamp from pointer, nil means NULL
+func NewNullTimestampFromPtr(ts *Timestamp) NullTimestamp {
+	if ts == nil {
+		return NullTimestamp{}
+	}
+	return NewNullTimestamp(*ts)
+}
+
+// Ptr convert to pointer, NULL means nil
+func (n NullTimestamp) Ptr() *Timestamp {
+	if !n.Valid {
+		return nil
+	}
+	ts := n.Timestamp
+	return &ts
+}
+
+// Equal reports whether both are NULL or both have same Timestamp
+func (n NullTimestamp) Equal(u NullTimestamp) bool {
+	if !n.Valid || !u.Valid {
+		return n.Valid == u.Valid
+	}
+	return n.Timestamp.Equal(u.Timestamp)
+}
+
+// UnmarshalJSON JSON null means NULL
+func (n *NullTimestamp) UnmarshalJSON(data []byte) error {
+	return n.unmarshalJSONWith(data, dateTimeJSONFormat)
+}
+
+// MarshalJSON NULL is marshalled to JSON null
+func (n NullTimestamp) MarshalJSON() ([]byte, error) {
+	return n.marshalJSONWith(dateTimeJSONFormat)
+}
+
+func (n *NullTimestamp) unmarshalJSONWith(data []byte, f JSONFormat) error {
+	*n = NullTimestamp{}
+	return unmarshalJSONNull(data, &n.Valid, func(data []byte) error {
+		return n.Timestamp.unmarshalJSONWith(data, f)
+	})
+}
+
+func (n NullTimestamp) marshalJSONWith(f JSONFormat) ([]byte, error) {
+	return marshalJSONNull(n.Valid, func() ([]byte, error) {
+		return n.Timestamp.marshalJSONWith(f)
+	})
+}
+
+// GormDataType column type for gorm AutoMigrate, the default type of Timestamp
+// NULL is explicit, since MySQL may declare TIMESTAMP columns NOT NULL by default.
+func (n NullTimestamp) GormDataType(gorm.Dialect) string {
+	return defaultDataType(n.Timestamp) + " NULL"
+}
+
+var _ driver.Valuer = NullTimestamp{}
+var _ sql.Scanner = &NullTimestamp{}
+var _ json.Marshaler = NullTimestamp{}
+var _ json.Unmarshaler = &NullTimestamp{}
+
+// Scan for sql.Scanner
+func (n *NullTimestamp) Scan(value interface{}) error {
+	*n = NullTimestamp{}
+	return scanNull(value, &n.Valid, n.Timestamp.Scan)
+}
+
+// Value for driver.Valuer
+func (n NullTimestamp) Value() (driver.Value, error) {
+	return valueNull(n.Valid, n.Timestamp.Value)
+}
+
+// NullLocalDateTime nullable LocalDateTime with sql.Null* semantics
+// NULL is represented as Valid == false and marshalled to JSON null.
+type NullLocalDateTime struct {
+	LocalDateTime LocalDateTime
+	Valid         bool
+}
+
+// NewNullLocalDateTime Create new valid NullLocalDateTime
+func NewNullLocalDateTime(ldt LocalDateTime) NullLocalDateTime {
+	return NullLocalDateTime{LocalDateTime: ldt, Valid: true}
+}
+
+// NewNullLocalDateTimeFromPtr Create new NullLocalDateTime from pointer, nil means NULL
+func NewNullLocalDateTimeFromPtr(ldt *LocalDateTime) NullLocalDateTime {
+	if ldt == nil {
+		return NullLocalDateTime{}
+	}
+	return NewNullLocalDateTime(*ldt)
+}
+
+// Ptr convert to pointer, NULL means nil
+func (n NullLocalDateTime) Ptr() *LocalDateTime {
+	if !n.Valid {
+		return nil
+	}
+	ldt := n.LocalDateTime
+	return &ldt
+}
+
+// Equal reports whether both are NULL or both have same LocalDateTime
+func (n NullLocalDateTime) Equal(u NullLocalDateTime) bool {
+	if !n.Valid || !u.Valid {
+		return n.Valid == u.Valid
+	}
+	return n.LocalDateTime.Equal(u.LocalDateTime)
+}
+
+// UnmarshalJSON JSON null means NULL
+func (n *NullLocalDateTime) UnmarshalJSON(data []byte) error {
+	*n = NullLocalDateTime{}
+	return unmarshalJSONNull(data, &n.Valid, n.LocalDateTime.UnmarshalJSON)
+}
+
+// MarshalJSON NULL is marshalled to JSON null
+func (n NullLocalDateTime) MarshalJSON() ([]byte, error) {
+	return marshalJSONNull(n.Valid, n.LocalDateTime.MarshalJSON)
+}
+
+// GormDataType column type for gorm AutoMigrate
+func (n NullLocalDateTime) GormDataType(dialect gorm.Dialect) string {
+	return n.LocalDateTime.GormDataType(dialect)
+}
+
+var _ driver.Valuer = NullLocalDateTime{}
+var _ sql.Scanner = &NullLocalDateTime{}
+var _ json.Marshaler = NullLocalDateTime{}
+var _ json.Unmarshaler = &NullLocalDateTime{}
+
+// Scan for sql.Scanner
+func (n *NullLocalDateTime) Scan(value interface{}) error {
+	*n = NullLocalDateTime{}
+	return scanNull(value, &n.Valid, n.LocalDateTime.Scan)
+}
+
+// Value for driver.Valuer
+func (n NullLocalDateTime) Value() (driver.Value, error) {
+	return valueNull(n.Valid, n.LocalDateTime.Value)
+}
+
+// NullDecimal nullable Decimal with sql.Null* semantics
+// NULL is represented as Valid == false and marshalled to JSON null.
+type NullDecimal struct {
+	Decimal Decimal `gorm:"type:decimal(65,30)"`
+	Valid   bool
+}
+
+// NewNullDecimal Create new valid NullDecimal
+func NewNullDecimal(d Decimal) NullDecimal {
+	return NullDecimal{Decimal: d, Valid: true}
+}
+
+// NewNullDecimalFromPtr Create new NullDecimal from pointer, nil means NULL
+func NewNullDecimalFromPtr(d *Decimal) NullDecimal {
+	if d == nil {
+		return NullDecimal{}
+	}
+	return NewNullDecimal(*d)
+}
+
+// Ptr convert to pointer, NULL means nil
+func (n NullDecimal) Ptr() *Decimal {
+	if !n.Valid {
+		return nil
+	}
+	d := n.Decimal
+	return &d
+}
+
+// Equal reports whether both are NULL or both have same Decimal
+func (n NullDecimal) Equal(u NullDecimal) bool {
+	if !n.Valid || !u.Valid {
+		return n.Valid == u.Valid
+	}
+	return n.Decimal.Equal(u.Decimal)
+}
+
+// UnmarshalJSON JSON null means NULL
+func (n *NullDecimal) UnmarshalJSON(data []byte) error {
+	*n = NullDecimal{}
+	return unmarshalJSONNull(data, &n.Valid, n.Decimal.UnmarshalJSON)
+}
+
+// MarshalJSON NULL is marshalled to JSON null
+func (n NullDecimal) MarshalJSON() ([]byte, error) {
+	return marshalJSONNull(n.Valid, n.Decimal.MarshalJSON)
+}
+
+var _ driver.Valuer = NullDecimal{}
+var _ sql.Scanner = &NullDecimal{}
+var _ json.Marshaler = NullDecimal{}
+var _ json.Unmarshaler = &NullDecimal{}
+
+// Scan for sql.Scanner
+func (n *NullDecimal) Scan(value interface{}) error {
+	*n = NullDecimal{}
+	return scanNull(value, &n.Valid, n.Decimal.Scan)
+}
+
+// Value for driver.Valuer
+func (n NullDecimal) Value() (driver.Value, error) {
+	return valueNull(n.Valid, n.Decimal.Value)
+}
@@ -0,0 +1,180 @@
+package mysqltype
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type NullFieldTestStruct struct {
+	ID             int
+	TargetDate     NullDate
+	TargetDateTime NullDateTime
+	TargetTime     NullTime
+	TargetYear     NullYear
+	TargetStamp    NullTimestamp
+}
+
+func TestNullField(t *testing.T) {
+	t.Parallel()
+	assert.NoError(t, DB.AutoMigrate(&NullFieldTestStruct{}).Error)
+
+	null := &NullFieldTestStruct{}
+	assert.NoError(t, DB.Create(null).Error)
+	dst := &NullFieldTestStruct{ID: null.ID}
+	assert.NoError(t, DB.First(dst).Error)
+	assert.Equal(t, null, dst)
+
+	valid := &NullFieldTestStruct{
+		TargetDate:     NewNullDate(NowDate()),
+		TargetDateTime: NewNullDateTime(NowDateTime().Truncate(time.Second)),
+		TargetTime:     NewNullTime(NewTime(100, 0, 0, 0)),
+		TargetYear:     NewNullYear(NowYear()),
+		TargetStamp:    NewNullTimestamp(NowTimestamp().Truncate(time.Second)),
+	}
+	assert.NoError(t, DB.Create(valid).Error)
+	dst = &NullFieldTestStruct{ID: valid.ID}
+	assert.NoError(t, DB.First(dst).Error)
+	assert.True(t, valid.TargetDate.Equal(dst.TargetDate))
+	assert.True(t, valid.TargetDateTime.Equal(dst.TargetDateTime))
+	assert.True(t, valid.TargetTime.Equal(dst.TargetTime))
+	assert.True(t, valid.TargetYear.Equal(dst.TargetYear))
+	assert.True(t, valid.TargetStamp.Equal(dst.TargetStamp))
+}
+
+func TestNullGormDataType(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "date", NullDate{}.GormDataType(nil))
+	assert.Equal(t, "datetime", NullDateTime{}.GormDataType(nil))
+	assert.Equal(t, "time(6)", NullTime{}.GormDataType(nil))
+	assert.Equal(t, "year", NullYear{}.GormDataType(nil))
+	assert.Equal(t, "timestamp NULL", NullTimestamp{}.GormDataType(nil))
+}
+
+func TestNullDateScan(t *testing.T) {
+	t.Parallel()
+	target := NewNullDate(NowDate())
+	assert.NoError(t, target.Scan(nil))
+	assert.False(t, target.Valid)
+	assert.True(t, target.Date.IsZero())
+
+	now := NowDate()
+	assert.NoError(t, target.Scan(now.Time()))
+	assert.True(t, target.Valid)
+	assertTimeEquals(t, now.Time(), target.Date.Time())
+	assertInvalidValueType(t, target.Scan(1.0))
+}
+
+func TestNullDateValue(t *testing.T) {
+	t.Parallel()
+	v, err := NullDate{}.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	v, err = NewNullDate(NewDate(2018, 8, 20)).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "2018-08-20", v)
+}
+
+func TestNullDateMarshalJSON(t *testing.T) {
+	t.Parallel()
+	type dto struct {
+		Date NullDate `json:"date"`
+	}
+	actual, err := json.Marshal(dto{})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"date":null}`, string(actual))
+
+	dst := dto{Date: NewNullDate(NowDate())}
+	assert.NoError(t, json.Unmarshal(actual, &dst))
+	assert.False(t, dst.Date.Valid)
+
+	src := dto{Date: NewNullDate(NewDate(2018, 8, 20))}
+	actual, err = json.Marshal(src)
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(actual, &dst))
+	assert.True(t, src.Date.Equal(dst.Date))
+}
+
+func TestNullDateFromPtr(t *testing.T) {
+	t.Parallel()
+	assert.False(t, NewNullDateFromPtr(nil).Valid)
+	assert.Nil(t, NullDate{}.Ptr())
+
+	now := NowDate()
+	n := NewNullDateFromPtr(&now)
+	assert.True(t, n.Valid)
+	assert.Equal(t, now, *n.Ptr())
+}
+
+func TestNullDateEqual(t *testing.T) {
+	t.Parallel()
+	assert.True(t, NullDate{}.Equal(NullDate{}))
+	assert.False(t, NullDate{}.Equal(NewNullDate(Date{})))
+	assert.True(t, NewNullDate(MinDate()).Equal(NewNullDate(MinDate())))
+	assert.False(t, NewNullDate(MinDate()).Equal(NewNullDate(MaxDate())))
+}
+
+func TestNullDateTimeScan(t *testing.T) {
+	t.Parallel()
+	target := NullDateTime{}
+	assert.NoError(t, target.Scan([]byte("2018-08-20 10:20:30")))
+	assert.True(t, target.Valid)
+	assert.True(t, NewDateTime(2018, 8, 20, 10, 20, 30, 0, time.UTC).Equal(target.DateTime))
+	assert.NoError(t, target.Scan(nil))
+	assert.False(t, target.Valid)
+}
+
+func TestNullDateTimeMarshalJSON(t *testing.T) {
+	t.Parallel()
+	src := NewNullDateTime(NowDateTime())
+	actual, err := json.Marshal(src)
+	assert.NoError(t, err)
+	dst := NullDateTime{}
+	assert.NoError(t, json.Unmarshal(actual, &dst))
+	assert.True(t, src.Equal(dst))
+
+	assert.NoError(t, json.Unmarshal([]byte("null"), &dst))
+	assert.False(t, dst.Valid)
+}
+
+func TestNullTimeValue(t *testing.T) {
+	t.Parallel()
+	v, err := NullTime{}.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+	v, err = NewNullTime(MaxTime()).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "838:59:59", v)
+}
+
+func TestNullYearMarshalJSON(t *testing.T) {
+	t.Parallel()
+	actual, err := json.Marshal([]NullYear{{}, NewNullYear(NewYear(2018))})
+	assert.NoError(t, err)
+	assert.Equal(t, "[null,2018]", string(actual))
+}
+
+func TestNullTimestampScan(t *testing.T) {
+	t.Parallel()
+	target := NullTimestamp{}
+	assert.NoError(t, target.Scan(nil))
+	assert.False(t, target.Valid)
+	_, err := NewNullTimestamp(NewTimestampFromTime(MaxDateTime().Time())).Value()
+	assertOutOfRange(t, err)
+}
+
+func TestNullDecimalScan(t *testing.T) {
+	t.Parallel()
+	target := NullDecimal{}
+	assert.NoError(t, target.Scan([]byte("-1.50")))
+	assert.True(t, target.Valid)
+	assert.Equal(t, "-1.50", target.Decimal.String())
+	assert.NoError(t, target.Scan(nil))
+	assert.False(t, target.Valid)
+	v, err := target.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+}
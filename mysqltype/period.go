@@ -0,0 +1,167 @@
+package mysqltype
+
+import (
+	"encoding"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// Period amount of date based time in years, months and days
+// https://en.wikipedia.org/wiki/ISO_8601#Durations
+// Each component may be negative, and months are not normalized into years unless Normalized is called.
+type Period struct {
+	Years  int
+	Months int
+	Days   int
+}
+
+// NewPeriod Create new Period
+func NewPeriod(years, months, days int) Period {
+	return Period{Years: years, Months: months, Days: days}
+}
+
+// PeriodBetween Period from a until b, negative if b is before a
+// Whole months are counted first with AddMonthsClamped and remaining days next,
+// so that a.AddPeriod(PeriodBetween(a, b)) is b, and all components have the same sign.
+func PeriodBetween(a, b Date) Period {
+	months := (b.Year()*12 + int(b.Month())) - (a.Year()*12 + int(a.Month()))
+	if c := a.AddMonthsClamped(months); months > 0 && c.After(b) {
+		months--
+	} else if months < 0 && c.Before(b) {
+		months++
+	}
+	days := b.DaysSince(a.AddMonthsClamped(months))
+	return Period{Years: months / 12, Months: months % 12, Days: days}
+}
+
+// AddPeriod add p to dt, adding years and months by AddMonthsClamped and days next
+func (dt Date) AddPeriod(p Period) Date {
+	return dt.AddMonthsClamped(p.TotalMonths()).AddDays(p.Days)
+}
+
+// IsZero reports whether all components are zero
+func (p Period) IsZero() bool {
+	return p.Years == 0 && p.Months == 0 && p.Days == 0
+}
+
+// TotalMonths years and months in months
+func (p Period) TotalMonths() int {
+	return p.Years*12 + p.Months
+}
+
+// Negated Period with each component negated
+func (p Period) Negated() Period {
+	return Period{Years: -p.Years, Months: -p.Months, Days: -p.Days}
+}
+
+// Normalized Period with months normalized into years
+// Days are kept as is, since the number of days in a month varies.
+func (p Period) Normalized() Period {
+	months := p.TotalMonths()
+	return Period{Years: months / 12, Months: months % 12, Days: p.Days}
+}
+
+// String format as ISO 8601 duration, e.g. "P1Y2M3D"
+// Zero components are omitted, and zero Period is "P0D".
+func (p Period) String() string {
+	if p.IsZero() {
+		return "P0D"
+	}
+	dst := "P"
+	if p.Years != 0 {
+		dst += strconv.Itoa(p.Years) + "Y"
+	}
+	if p.Months != 0 {
+		dst += strconv.Itoa(p.Months) + "M"
+	}
+	if p.Days != 0 {
+		dst += strconv.Itoa(p.Days) + "D"
+	}
+	return dst
+}
+
+// ParsePeriod parse ISO 8601 duration of years, months, weeks and days, e.g. "P1Y2M3D" or "P2W"
+// A leading sign negates all components, and each component may have its own sign as "P-1Y2M".
+// Weeks are converted to days, and time components are not supported.
+func ParsePeriod(s string) (Period, error) {
+	sign := 1
+	if strings.HasPrefix(s, "-") {
+		sign, s = -1, s[1:]
+	} else if strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+	if len(s) < 3 || (s[0] != 'P' && s[0] != 'p') {
+		return Period{}, ErrInvalidFormat
+	}
+	var p Period
+	order := "YMWD"
+	for s = s[1:]; s != ""; {
+		i := strings.IndexAny(s, "YMWDymwd")
+		if i <= 0 {
+			return Period{}, ErrInvalidFormat
+		}
+		n, err := strconv.Atoi(s[:i])
+		if err != nil {
+			return Period{}, ErrInvalidFormat
+		}
+		unit := strings.ToUpper(s[i : i+1])
+		j := strings.Index(order, unit)
+		if j < 0 {
+			return Period{}, ErrInvalidFormat
+		}
+		order = order[j+1:]
+		switch unit {
+		case "Y":
+			p.Years = n
+		case "M":
+			p.Months = n
+		case "W":
+			p.Days += n * 7
+		case "D":
+			p.Days += n
+		}
+		s = s[i+1:]
+	}
+	if sign < 0 {
+		p = p.Negated()
+	}
+	return p, nil
+}
+
+// UnmarshalText parse ISO 8601 duration
+func (p *Period) UnmarshalText(text []byte) error {
+	dst, err := ParsePeriod(string(text))
+	if err != nil {
+		return err
+	}
+	*p = dst
+	return nil
+}
+
+// MarshalText format as ISO 8601 duration
+func (p Period) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalJSON parse ISO 8601 duration in JSON string
+func (p *Period) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	s, err := strconv.Unquote(string(data))
+	if err != nil {
+		return ErrInvalidFormat
+	}
+	return p.UnmarshalText([]byte(s))
+}
+
+// MarshalJSON format as ISO 8601 duration in JSON string
+func (p Period) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(p.String())), nil
+}
+
+var _ encoding.TextUnmarshaler = &Period{}
+var _ encoding.TextMarshaler = Period{}
+var _ json.Marshaler = Period{}
+var _ json.Unmarshaler = &Period{}
@@ -0,0 +1,87 @@
+package mysqltype
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeriodBetween(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		a        Date
+		b        Date
+		expected Period
+	}{
+		{NewDate(2018, 8, 20), NewDate(2018, 8, 20), Period{}},
+		{NewDate(2018, 8, 20), NewDate(2019, 10, 23), NewPeriod(1, 2, 3)},
+		{NewDate(2018, 8, 20), NewDate(2018, 9, 19), NewPeriod(0, 0, 30)},
+		{NewDate(2024, 1, 31), NewDate(2024, 2, 29), NewPeriod(0, 1, 0)},
+		{NewDate(2024, 1, 31), NewDate(2024, 3, 1), NewPeriod(0, 1, 1)},
+		{NewDate(2019, 10, 23), NewDate(2018, 8, 20), NewPeriod(-1, -2, -3)},
+		{NewDate(2024, 3, 31), NewDate(2024, 2, 28), NewPeriod(0, -1, -1)},
+		{NewDate(2024, 3, 31), NewDate(2024, 2, 29), NewPeriod(0, -1, 0)},
+		{MinDate(), MaxDate(), NewPeriod(8999, 11, 30)},
+	}
+	for _, tt := range tests {
+		actual := PeriodBetween(tt.a, tt.b)
+		assert.Equal(t, tt.expected, actual, "%v - %v", tt.b, tt.a)
+		assert.Equal(t, tt.b, tt.a.AddPeriod(actual), "%v + %v", tt.a, actual)
+	}
+}
+
+func TestParsePeriod(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		src      string
+		expected Period
+	}{
+		{"P1Y2M3D", NewPeriod(1, 2, 3)},
+		{"P1Y", NewPeriod(1, 0, 0)},
+		{"P2M", NewPeriod(0, 2, 0)},
+		{"P0D", Period{}},
+		{"P2W", NewPeriod(0, 0, 14)},
+		{"P1W3D", NewPeriod(0, 0, 10)},
+		{"P-1Y2M", NewPeriod(-1, 2, 0)},
+		{"-P1Y2M", NewPeriod(-1, -2, 0)},
+		{"+P1D", NewPeriod(0, 0, 1)},
+		{"p1y", NewPeriod(1, 0, 0)},
+	}
+	for _, tt := range tests {
+		actual, err := ParsePeriod(tt.src)
+		assert.NoError(t, err, tt.src)
+		assert.Equal(t, tt.expected, actual, tt.src)
+	}
+
+	for _, src := range []string{"", "P", "1Y", "PY", "P1D2M", "P1Y1Y", "P1H", "PT1H", "P1.5Y", "P1Y2"} {
+		_, err := ParsePeriod(src)
+		assert.Equal(t, ErrInvalidFormat, err, src)
+	}
+}
+
+func TestPeriodString(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "P1Y2M3D", NewPeriod(1, 2, 3).String())
+	assert.Equal(t, "P0D", Period{}.String())
+	assert.Equal(t, "P14M", NewPeriod(0, 14, 0).String())
+	assert.Equal(t, "P1Y2M", NewPeriod(0, 14, 0).Normalized().String())
+	assert.Equal(t, "P-1Y-2M-3D", NewPeriod(1, 2, 3).Negated().String())
+	assert.Equal(t, "P-1Y-2M", NewPeriod(0, -14, 0).Normalized().String())
+}
+
+func TestPeriodMarshalJSON(t *testing.T) {
+	t.Parallel()
+	src := struct {
+		Term Period `json:"term"`
+	}{NewPeriod(1, 2, 3)}
+	data, err := json.Marshal(src)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"term":"P1Y2M3D"}`, string(data))
+
+	dst := src
+	dst.Term = Period{}
+	assert.NoError(t, json.Unmarshal(data, &dst))
+	assert.Equal(t, src, dst)
+	assert.Equal(t, ErrInvalidFormat, json.Unmarshal([]byte(`{"term":1}`), &dst))
+}
@@ -0,0 +1,146 @@
+package mysqltype
+
+import (
+	"time"
+)
+
+// dateTimeLayouts textual layouts accepted by Scan in order of precedence
+var dateTimeLayouts = []string{
+	dateTimeFormatLayout,
+	"2006-01-02T15:04:05.999999999",
+	time.RFC3339Nano,
+	"2006-01-02 15:04",
+	"2006-01-02T15:04",
+	"20060102150405",
+	dateFormatLayout,
+	"20060102",
+}
+
+// dateLayouts textual layouts accepted by Date.Scan in order of precedence
+var dateLayouts = []string{
+	dateFormatLayout,
+	dateTimeFormatLayout,
+	"2006-01-02T15:04:05.999999999",
+	time.RFC3339Nano,
+	"20060102",
+}
+
+// scanTime convert value from driver to time.Time
+// It accepts time.Time, textual date and time as []byte or string, and unix time as int64.
+// Text without time zone and unix time are interpreted in the location set by SetLocation.
+// MySQL DATE and DATETIME text is parsed by parseMySQLTime without allocation, and others by layouts,
+// so layouts must accept dateFormatLayout and dateTimeFormatLayout.
+func scanTime(value interface{}, target string, layouts []string) (time.Time, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, nil
+	case []byte:
+		// string(v) does not allocate for MySQL text, since it is short and does not escape parseMySQLTime
+		if t, ok := parseMySQLTime(string(v), location); ok {
+			return t, nil
+		}
+		return parseTimeText(string(v), layouts)
+	case string:
+		if t, ok := parseMySQLTime(v, location); ok {
+			return t, nil
+		}
+		return parseTimeText(v, layouts)
+	case int64:
+		return time.Unix(v, 0).In(location), nil
+	}
+	return time.Time{}, &InvalidValueTypeError{Value: value, Target: target}
+}
+
+// parseTimeText parse s with layouts
+// JSON string such as JSON_EXTRACT result is unquoted.
+// It returns the error of the first layout if none of layouts matches.
+func parseTimeText(s string, layouts []string) (time.Time, error) {
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+	}
+	var firstErr error
+	for _, layout := range layouts {
+		t, err := time.ParseInLocation(layout, s, location)
+		if err == nil {
+			return t, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return time.Time{}, firstErr
+}
+
+// parseMySQLTime parse MySQL DATE and DATETIME text "YYYY-MM-DD" and "YYYY-MM-DD HH:MM:SS[.fraction]" in loc
+// It reports false for any other text including out of range fields, and then callers fall back to time.Parse.
+// The result is same as time.ParseInLocation with dateFormatLayout or dateTimeFormatLayout.
+func parseMySQLTime(s string, loc *time.Location) (time.Time, bool) {
+	if len(s) != len(dateFormatLayout) && len(s) < mySQLDateTimeLen {
+		return time.Time{}, false
+	}
+	if s[4] != '-' || s[7] != '-' {
+		return time.Time{}, false
+	}
+	year, ok1 := parseDigits(s[0:4])
+	month, ok2 := parseDigits(s[5:7])
+	day, ok3 := parseDigits(s[8:10])
+	if !ok1 || !ok2 || !ok3 || month < 1 || 12 < month || day < 1 || daysIn(year, time.Month(month)) < day {
+		return time.Time{}, false
+	}
+	if len(s) == len(dateFormatLayout) {
+		return time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc), true
+	}
+
+	if s[10] != ' ' || s[13] != ':' || s[16] != ':' {
+		return time.Time{}, false
+	}
+	hour, ok1 := parseDigits(s[11:13])
+	min, ok2 := parseDigits(s[14:16])
+	sec, ok3 := parseDigits(s[17:19])
+	if !ok1 || !ok2 || !ok3 || 23 < hour || 59 < min || 59 < sec {
+		return time.Time{}, false
+	}
+	nsec := 0
+	if fraction := s[mySQLDateTimeLen:]; len(fraction) > 0 {
+		if fraction[0] != '.' || len(fraction) < 2 || 10 < len(fraction) {
+			return time.Time{}, false
+		}
+		var ok bool
+		if nsec, ok = parseDigits(fraction[1:]); !ok {
+			return time.Time{}, false
+		}
+		for i := len(fraction); i < 10; i++ {
+			nsec *= 10
+		}
+	}
+	return time.Date(year, time.Month(month), day, hour, min, sec, nsec, loc), true
+}
+
+// mySQLDateTimeLen length of "YYYY-MM-DD HH:MM:SS"
+const mySQLDateTimeLen = len("2006-01-02 15:04:05")
+
+// parseDigits parse s consisting of only ASCII digits
+func parseDigits(s string) (int, bool) {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || '9' < s[i] {
+			return 0, false
+		}
+		n = n*10 + int(s[i]-'0')
+	}
+	return n, true
+}
+
+// daysIn number of days in month of year
+func daysIn(year int, month time.Month) int {
+	switch month {
+	case time.February:
+		if year%4 == 0 && (year%100 != 0 || year%400 == 0) {
+			return 29
+		}
+		return 28
+	case time.April, time.June, time.September, time.November:
+		return 30
+	}
+	return 31
+}
@@ -0,0 +1,42 @@
+//go:build go1.18
+// +build go1.18
+
+package mysqltype
+
+import (
+	"testing"
+	"time"
+)
+
+func FuzzParseMySQLTime(f *testing.F) {
+	for _, seed := range []string{
+		"2018-08-20",
+		"2018-08-20 10:20:30",
+		"2018-08-20 10:20:30.123456",
+		"2016-02-29 23:59:59.999999999",
+		"0000-01-01",
+		"2018-02-29",
+		"2018-08-20 24:00:00",
+		"2018-08-20T10:20:30Z",
+	} {
+		f.Add(seed)
+	}
+	asiaTokyo := time.FixedZone("Asia/Tokyo", 9*60*60)
+	f.Fuzz(func(t *testing.T, s string) {
+		actual, ok := parseMySQLTime(s, asiaTokyo)
+		layout := dateTimeFormatLayout
+		if len(s) == len(dateFormatLayout) {
+			layout = dateFormatLayout
+		}
+		expected, err := time.ParseInLocation(layout, s, asiaTokyo)
+		if !ok {
+			return
+		}
+		if err != nil {
+			t.Fatalf("%q: parseMySQLTime accepted %v, but time.Parse failed: %v", s, actual, err)
+		}
+		if !actual.Equal(expected) || actual.Location() != expected.Location() {
+			t.Fatalf("%q: expected %v, actual %v", s, expected, actual)
+		}
+	})
+}
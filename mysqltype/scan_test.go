@@ -0,0 +1,97 @@
+package mysqltype
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMySQLTime(t *testing.T) {
+	t.Parallel()
+	asiaTokyo := time.FixedZone("Asia/Tokyo", 9*60*60)
+	tests := []struct {
+		src      string
+		expected time.Time
+	}{
+		{"2018-08-20", time.Date(2018, 8, 20, 0, 0, 0, 0, asiaTokyo)},
+		{"2018-08-20 10:20:30", time.Date(2018, 8, 20, 10, 20, 30, 0, asiaTokyo)},
+		{"2018-08-20 10:20:30.1", time.Date(2018, 8, 20, 10, 20, 30, 100000000, asiaTokyo)},
+		{"2018-08-20 10:20:30.123456", time.Date(2018, 8, 20, 10, 20, 30, 123456000, asiaTokyo)},
+		{"2018-08-20 10:20:30.123456789", time.Date(2018, 8, 20, 10, 20, 30, 123456789, asiaTokyo)},
+		{"2016-02-29 23:59:59", time.Date(2016, 2, 29, 23, 59, 59, 0, asiaTokyo)},
+		{"1000-01-01", time.Date(1000, 1, 1, 0, 0, 0, 0, asiaTokyo)},
+		{"9999-12-31 23:59:59.999999", time.Date(9999, 12, 31, 23, 59, 59, 999999000, asiaTokyo)},
+	}
+	for _, tt := range tests {
+		actual, ok := parseMySQLTime(tt.src, asiaTokyo)
+		assert.True(t, ok, tt.src)
+		assert.Equal(t, tt.expected, actual, tt.src)
+	}
+
+	for _, src := range []string{
+		"", "2018-08", "2018/08/20", "2018-8-20", "2018-08-20T10:20:30", "2018-08-20 10:20",
+		"2018-13-01", "2018-00-01", "2018-02-29", "2018-04-31", "2018-08-00",
+		"2018-08-20 24:00:00", "2018-08-20 10:60:00", "2018-08-20 10:20:60",
+		"2018-08-20 10:20:30.", "2018-08-20 10:20:30,123", "2018-08-20 10:20:30.1234567890",
+		"2018-08-20 10:20:30Z", "+018-08-20", "2018-08-2a",
+	} {
+		_, ok := parseMySQLTime(src, time.UTC)
+		assert.False(t, ok, src)
+	}
+}
+
+func TestScanTimeAllocs(t *testing.T) {
+	var date interface{} = []byte("2018-08-20")
+	var dateTime interface{} = []byte("2018-08-20 10:20:30.123456")
+	var d Date
+	var dt DateTime
+	assert.Equal(t, 0.0, testing.AllocsPerRun(100, func() { _ = d.Scan(date) }))
+	assert.Equal(t, 0.0, testing.AllocsPerRun(100, func() { _ = dt.Scan(dateTime) }))
+}
+
+func TestDaysIn(t *testing.T) {
+	t.Parallel()
+	for year := 1896; year <= 2404; year++ {
+		for month := time.January; month <= time.December; month++ {
+			expected := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+			if actual := daysIn(year, month); actual != expected {
+				t.Fatalf("%d-%02d: expected %d, actual %d", year, month, expected, actual)
+			}
+		}
+	}
+}
+
+func BenchmarkScanDate(b *testing.B) {
+	var value interface{} = []byte("2018-08-20")
+	b.Run("time.Parse", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = parseTimeText(string(value.([]byte)), dateLayouts)
+		}
+	})
+	b.Run("parseMySQLTime", func(b *testing.B) {
+		b.ReportAllocs()
+		var dt Date
+		for i := 0; i < b.N; i++ {
+			_ = dt.Scan(value)
+		}
+	})
+}
+
+func BenchmarkScanDateTime(b *testing.B) {
+	var value interface{} = []byte("2018-08-20 10:20:30.123456")
+	b.Run("time.Parse", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = parseTimeText(string(value.([]byte)), dateTimeLayouts)
+		}
+	})
+	b.Run("parseMySQLTime", func(b *testing.B) {
+		b.ReportAllocs()
+		var dt DateTime
+		for i := 0; i < b.N; i++ {
+			_ = dt.Scan(value)
+		}
+	})
+}
@@ -1,6 +1,7 @@
 package mysqltype
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -17,6 +18,16 @@ func assertTimeEquals(t *testing.T, expected, actual time.Time) {
 	assert.Truef(t, actual.Equal(expected), "unexpected,  expected: `%s`,actual: `%s`", expected, actual)
 }
 
+func assertOutOfRange(t *testing.T, err error) {
+	_, ok := err.(*OutOfRangeError)
+	assert.Truef(t, ok && errors.Is(err, ErrOutOfRange), "unexpected error: `%v`", err)
+}
+
+func assertInvalidValueType(t *testing.T, err error) {
+	_, ok := err.(*InvalidValueTypeError)
+	assert.Truef(t, ok && errors.Is(err, ErrInvalidValueType), "unexpected error: `%v`", err)
+}
+
 func assertMySQLErrNumber(t *testing.T, err error, number uint16) {
 	if err != nil {
 		mysqlError, ok := err.(*mysql.MySQLError)
@@ -31,3 +42,8 @@ func assertMySQLErrNumber(t *testing.T, err error, number uint16) {
 	}
 
 }
+
+func assertUnknownEnumValue(t *testing.T, err error) {
+	_, ok := err.(*UnknownEnumValueError)
+	assert.Truef(t, ok && errors.Is(err, ErrUnknownEnumValue), "unexpected error: `%v`", err)
+}
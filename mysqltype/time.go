@@ -0,0 +1,364 @@
+package mysqltype
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/binary"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+// Time support MySQL Time type
+// https://dev.mysql.com/doc/refman/8.0/en/time.html
+// Time holds elapsed time or time of day, so it may be negative or exceed 24 hours.
+type Time struct {
+	src time.Duration `gorm:"type:time"`
+}
+
+const (
+	maxTimeDuration = 838*time.Hour + 59*time.Minute + 59*time.Second
+	minTimeDuration = -maxTimeDuration
+)
+
+// NewTime Create new Time from each part
+// Each part is summed up, so NewTime(-1, -30, 0, 0) means -01:30:00
+func NewTime(hour, min, sec, nsec int) Time {
+	d := time.Duration(hour)*time.Hour +
+		time.Duration(min)*time.Minute +
+		time.Duration(sec)*time.Second +
+		time.Duration(nsec)
+	return NewTimeFromDuration(d)
+}
+
+// NewTimeFromDuration Create new Time from time.Duration
+func NewTimeFromDuration(d time.Duration) Time {
+	return Time{src: d}
+}
+
+// NewTimeFromTime Create new Time from clock of Time
+func NewTimeFromTime(t time.Time) Time {
+	hour, min, sec := t.Clock()
+	return NewTime(hour, min, sec, t.Nanosecond())
+}
+
+// MinTime Minimum Time
+func MinTime() Time {
+	return NewTimeFromDuration(minTimeDuration)
+}
+
+// MaxTime Maximum Time
+func MaxTime() Time {
+	return NewTimeFromDuration(maxTimeDuration)
+}
+
+// NowTime Create Now time of day for MySQL DataBase
+func NowTime() Time {
+	return NewTimeFromTime(time.Now())
+}
+
+// After behavior as time.Time
+func (tm Time) After(u Time) bool {
+	return tm.src > u.src
+}
+
+// Before behavior as time.Time
+func (tm Time) Before(u Time) bool {
+	return tm.src < u.src
+}
+
+// Equal behavior as time.Time
+func (tm Time) Equal(u Time) bool {
+	return tm.src == u.src
+}
+
+// Duration convert to time.Duration
+func (tm Time) Duration() time.Duration {
+	return tm.src
+}
+
+// Sub behavior as time.Time
+func (tm Time) Sub(u Time) time.Duration {
+	return tm.src - u.src
+}
+
+// Add behavior as time.Time
+func (tm Time) Add(d time.Duration) Time {
+	return NewTimeFromDuration(tm.src + d)
+}
+
+// Round behavior as time.Duration
+func (tm Time) Round(d time.Duration) Time {
+	return NewTimeFromDuration(tm.src.Round(d))
+}
+
+// Truncate behavior as time.Duration
+func (tm Time) Truncate(d time.Duration) Time {
+	return NewTimeFromDuration(tm.src.Truncate(d))
+}
+
+// IsZero reports whether tm is 00:00:00
+func (tm Time) IsZero() bool {
+	return tm.src == 0
+}
+
+// IsNegative reports whether tm is less than 00:00:00
+func (tm Time) IsNegative() bool {
+	return tm.src < 0
+}
+
+// IsValid reports whether tm is in MySQL TIME range
+func (tm Time) IsValid() bool {
+	return minTimeDuration <= tm.src && tm.src <= maxTimeDuration
+}
+
+func (tm Time) validate() error {
+	if !tm.IsValid() {
+		return &OutOfRangeError{Value: tm, Min: MinTime(), Max: MaxTime()}
+	}
+	return nil
+}
+
+// Clock returns hour, minute and second of absolute value
+func (tm Time) Clock() (hour, min, sec int) {
+	d := tm.abs()
+	hour = int(d / time.Hour)
+	min = int(d % time.Hour / time.Minute)
+	sec = int(d % time.Minute / time.Second)
+	return
+}
+
+// Hour hour of absolute value, may exceed 23
+func (tm Time) Hour() int {
+	hour, _, _ := tm.Clock()
+	return hour
+}
+
+// Minute minute of absolute value
+func (tm Time) Minute() int {
+	_, min, _ := tm.Clock()
+	return min
+}
+
+// Second second of absolute value
+func (tm Time) Second() int {
+	_, _, sec := tm.Clock()
+	return sec
+}
+
+// Nanosecond fractional second of absolute value
+func (tm Time) Nanosecond() int {
+	return int(tm.abs() % time.Second)
+}
+
+// String format as MySQL TIME literal
+func (tm Time) String() string {
+	return tm.format()
+}
+
+// UnmarshalText parse MySQL TIME literal
+func (tm *Time) UnmarshalText(text []byte) error {
+	d, err := parseTime(string(text))
+	if err != nil {
+		return err
+	}
+	tm.src = d
+	return nil
+}
+
+// MarshalText format as MySQL TIME literal
+func (tm Time) MarshalText() ([]byte, error) {
+	if err := tm.validate(); err != nil {
+		return nil, err
+	}
+	return []byte(tm.format()), nil
+}
+
+const timeBinaryVersion byte = 1
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler
+func (tm *Time) UnmarshalBinary(data []byte) error {
+	if len(data) != 9 || data[0] != timeBinaryVersion {
+		return ErrInvalidFormat
+	}
+	v := NewTimeFromDuration(time.Duration(binary.BigEndian.Uint64(data[1:])))
+	if err := v.validate(); err != nil {
+		return err
+	}
+	tm.src = v.src
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler
+func (tm Time) MarshalBinary() ([]byte, error) {
+	if err := tm.validate(); err != nil {
+		return nil, err
+	}
+	data := make([]byte, 9)
+	data[0] = timeBinaryVersion
+	binary.BigEndian.PutUint64(data[1:], uint64(tm.src))
+	return data, nil
+}
+
+// GobEncode encode as MarshalBinary
+func (tm Time) GobEncode() ([]byte, error) {
+	return tm.MarshalBinary()
+}
+
+// GobDecode decode as UnmarshalBinary
+func (tm *Time) GobDecode(data []byte) error {
+	return tm.UnmarshalBinary(data)
+}
+
+// UnmarshalJSON parse MySQL TIME literal in JSON string
+func (tm *Time) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	s, err := strconv.Unquote(string(data))
+	if err != nil {
+		return ErrInvalidFormat
+	}
+	return tm.UnmarshalText([]byte(s))
+}
+
+// MarshalJSON format as MySQL TIME literal in JSON string
+func (tm Time) MarshalJSON() ([]byte, error) {
+	text, err := tm.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(strconv.Quote(string(text))), nil
+}
+
+// GormDataType column type for gorm AutoMigrate
+func (Time) GormDataType(gorm.Dialect) string {
+	return "time(6)"
+}
+
+var _ driver.Valuer = Time{}
+var _ sql.Scanner = &Time{}
+var _ encoding.TextUnmarshaler = &Time{}
+var _ encoding.TextMarshaler = Time{}
+var _ encoding.BinaryMarshaler = Time{}
+var _ encoding.BinaryUnmarshaler = &Time{}
+var _ json.Marshaler = Time{}
+var _ json.Unmarshaler = &Time{}
+
+// Scan for sql.Scanner
+func (tm *Time) Scan(value interface{}) error {
+	var s string
+	switch v := value.(type) {
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	default:
+		return &InvalidValueTypeError{Value: value, Target: "Time"}
+	}
+	d, err := parseTime(s)
+	if err != nil {
+		return err
+	}
+	tm.src = d
+	return nil
+}
+
+// Value for driver.Valuer
+func (tm Time) Value() (driver.Value, error) {
+	if err := tm.validate(); err != nil {
+		return nil, err
+	}
+	return tm.format(), nil
+}
+
+func (tm Time) abs() time.Duration {
+	if tm.src < 0 {
+		return -tm.src
+	}
+	return tm.src
+}
+
+func (tm Time) format() string {
+	hour, min, sec := tm.Clock()
+	b := make([]byte, 0, 20)
+	if tm.src < 0 {
+		b = append(b, '-')
+	}
+	if hour < 10 {
+		b = append(b, '0')
+	}
+	b = strconv.AppendInt(b, int64(hour), 10)
+	b = append(b, ':', byte('0'+min/10), byte('0'+min%10))
+	b = append(b, ':', byte('0'+sec/10), byte('0'+sec%10))
+	if nsec := tm.Nanosecond(); nsec != 0 {
+		frac := strconv.Itoa(nsec + int(time.Second))[1:]
+		b = append(b, '.')
+		b = append(b, strings.TrimRight(frac, "0")...)
+	}
+	return string(b)
+}
+
+// parseTime parse "[-]HHH:MM:SS[.fraction]" or "[-]HHH:MM"
+func parseTime(s string) (time.Duration, error) {
+	negative := strings.HasPrefix(s, "-")
+	if negative {
+		s = s[1:]
+	}
+	var frac string
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		s, frac = s[:i], s[i+1:]
+		if len(frac) == 0 || len(frac) > 9 || !isDigits(frac) {
+			return 0, ErrInvalidFormat
+		}
+	}
+	parts := strings.Split(s, ":")
+	if len(parts) == 2 && frac == "" {
+		parts = append(parts, "00")
+	}
+	if len(parts) != 3 {
+		return 0, ErrInvalidFormat
+	}
+	for i, p := range parts {
+		if !isDigits(p) || (i > 0 && len(p) != 2) || (i == 0 && len(p) > 3) {
+			return 0, ErrInvalidFormat
+		}
+	}
+	hour, _ := strconv.Atoi(parts[0])
+	min, _ := strconv.Atoi(parts[1])
+	sec, _ := strconv.Atoi(parts[2])
+	if min > 59 || sec > 59 {
+		return 0, ErrInvalidFormat
+	}
+	nsec := 0
+	if frac != "" {
+		nsec, _ = strconv.Atoi(frac + strings.Repeat("0", 9-len(frac)))
+	}
+	d := time.Duration(hour)*time.Hour +
+		time.Duration(min)*time.Minute +
+		time.Duration(sec)*time.Second +
+		time.Duration(nsec)
+	if negative {
+		d = -d
+	}
+	if err := NewTimeFromDuration(d).validate(); err != nil {
+		return 0, err
+	}
+	return d, nil
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || '9' < s[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,200 @@
+package mysqltype
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type TimeFieldTestStruct struct {
+	ID         int
+	TargetTime Time `gorm:"not null"`
+}
+
+type TimeNullFieldTestStruct struct {
+	ID         int
+	TargetTime *Time
+}
+
+func TestTimeField(t *testing.T) {
+	t.Parallel()
+	target := &TimeFieldTestStruct{
+		TargetTime: NewTime(-100, 20, 30, 123456000),
+	}
+	assert.NoError(t, DB.AutoMigrate(target).Error)
+	assert.NoError(t, DB.Create(target).Error)
+
+	dummy := &TimeNullFieldTestStruct{}
+
+	assertMySQLErrNumber(t, DB.Table("time_field_test_structs").Create(&dummy).Error, mySQLNullError)
+	dst := &TimeFieldTestStruct{
+		ID: target.ID,
+	}
+	assert.NoError(t, DB.First(dst).Error)
+	assert.Equal(t, target.TargetTime, dst.TargetTime)
+
+	assert.NoError(t, DB.Save(&TimeFieldTestStruct{TargetTime: MaxTime()}).Error)
+	assert.NoError(t, DB.Save(&TimeFieldTestStruct{TargetTime: MinTime()}).Error)
+	assert.Error(t, DB.Save(&TimeFieldTestStruct{TargetTime: MaxTime().Add(time.Second)}).Error)
+}
+
+func TestNewTime(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, 90*time.Minute, NewTime(1, 30, 0, 0).Duration())
+	assert.Equal(t, -90*time.Minute, NewTime(-1, -30, 0, 0).Duration())
+	assert.Equal(t, 25*time.Hour, NewTime(25, 0, 0, 0).Duration())
+}
+
+func TestNewTimeFromTime(t *testing.T) {
+	t.Parallel()
+	v := NewTimeFromTime(time.Date(2008, 10, 12, 10, 3, 9, 8884, time.UTC))
+	assert.Equal(t, NewTime(10, 3, 9, 8884), v)
+}
+
+func TestTimeMarshalText(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		v        Time
+		expected string
+	}{
+		{NewTime(0, 0, 0, 0), "00:00:00"},
+		{NewTime(1, 2, 3, 0), "01:02:03"},
+		{NewTime(1, 2, 3, 400000000), "01:02:03.4"},
+		{NewTime(1, 2, 3, 123456), "01:02:03.000123456"},
+		{NewTime(-1, -2, -3, 0), "-01:02:03"},
+		{MaxTime(), "838:59:59"},
+		{MinTime(), "-838:59:59"},
+	}
+	for _, tt := range tests {
+		actual, err := tt.v.MarshalText()
+		assert.NoError(t, err)
+		assert.Equal(t, tt.expected, string(actual))
+	}
+	_, err := MaxTime().Add(time.Nanosecond).MarshalText()
+	assertOutOfRange(t, err)
+}
+
+func TestTimeUnmarshalText(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		text     string
+		expected Time
+	}{
+		{"00:00:00", NewTime(0, 0, 0, 0)},
+		{"01:02:03.4", NewTime(1, 2, 3, 400000000)},
+		{"-01:02:03.000001", NewTime(-1, -2, -3, -1000)},
+		{"838:59:59", MaxTime()},
+		{"-838:59:59", MinTime()},
+		{"12:30", NewTime(12, 30, 0, 0)},
+	}
+	for _, tt := range tests {
+		actual := Time{}
+		assert.NoError(t, actual.UnmarshalText([]byte(tt.text)))
+		assert.Equal(t, tt.expected, actual)
+	}
+
+	for _, text := range []string{"", "1", "01:60:00", "01:00:60", "01:00:00.", "a:00:00", "1000:00:00"} {
+		actual := Time{}
+		assert.Equal(t, ErrInvalidFormat, actual.UnmarshalText([]byte(text)), text)
+	}
+	for _, text := range []string{"839:00:00", "-838:59:59.1"} {
+		actual := Time{}
+		assertOutOfRange(t, actual.UnmarshalText([]byte(text)))
+	}
+}
+
+func TestTimeMarshalJSON(t *testing.T) {
+	t.Parallel()
+	v := NewTime(-30, 0, -1, -500000000)
+	actual, err := json.Marshal(v)
+	assert.NoError(t, err)
+	assert.Equal(t, `"-30:00:01.5"`, string(actual))
+
+	dst := Time{}
+	assert.NoError(t, json.Unmarshal(actual, &dst))
+	assert.Equal(t, v, dst)
+}
+
+func TestTimeMarshalBinary(t *testing.T) {
+	t.Parallel()
+	v := NewTime(-30, 0, -1, -500000000)
+	data, err := v.MarshalBinary()
+	assert.NoError(t, err)
+	dst := Time{}
+	assert.NoError(t, dst.UnmarshalBinary(data))
+	assert.Equal(t, v, dst)
+	assert.Equal(t, ErrInvalidFormat, dst.UnmarshalBinary(data[1:]))
+}
+
+func TestTimeValue(t *testing.T) {
+	t.Parallel()
+	v, err := NewTime(100, 0, 0, 0).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "100:00:00", v)
+
+	_, err = MinTime().Add(-time.Second).Value()
+	assertOutOfRange(t, err)
+}
+
+func TestTimeScan(t *testing.T) {
+	t.Parallel()
+	target := Time{}
+	assert.NoError(t, target.Scan([]byte("-12:34:56.789")))
+	assert.Equal(t, NewTime(-12, -34, -56, -789000000), target)
+	assert.NoError(t, target.Scan("838:59:59"))
+	assert.Equal(t, MaxTime(), target)
+	assertInvalidValueType(t, target.Scan(12))
+}
+
+func TestTimeAfterAndBefore(t *testing.T) {
+	t.Parallel()
+	v1 := NewTime(-1, 0, 0, 0)
+	v2 := NewTime(0, 0, 0, 0)
+
+	assert.True(t, v2.After(v1))
+	assert.False(t, v1.After(v2))
+	assert.True(t, v1.Before(v2))
+	assert.False(t, v2.Before(v1))
+}
+
+func TestTimeEqual(t *testing.T) {
+	t.Parallel()
+	assert.True(t, MinTime().Equal(MinTime()))
+	assert.False(t, MaxTime().Equal(MinTime()))
+}
+
+func TestTimeAddAndSub(t *testing.T) {
+	t.Parallel()
+	v := NewTime(23, 0, 0, 0)
+	assert.Equal(t, NewTime(25, 0, 0, 0), v.Add(2*time.Hour))
+	assert.Equal(t, 24*time.Hour, v.Sub(NewTime(-1, 0, 0, 0)))
+}
+
+func TestTimeRoundAndTruncate(t *testing.T) {
+	t.Parallel()
+	v := NewTime(10, 3, 8, 8884)
+	assert.Equal(t, NewTime(10, 3, 10, 0), v.Round(5*time.Second))
+	assert.Equal(t, NewTime(10, 3, 5, 0), v.Truncate(5*time.Second))
+}
+
+func TestTimeIsZero(t *testing.T) {
+	t.Parallel()
+	assert.True(t, Time{}.IsZero())
+	assert.False(t, MaxTime().IsZero())
+}
+
+func TestTimeClock(t *testing.T) {
+	t.Parallel()
+	min := MinTime()
+	hour, minute, sec := min.Clock()
+	assert.True(t, min.IsNegative())
+	assert.Equal(t, 838, hour)
+	assert.Equal(t, 59, minute)
+	assert.Equal(t, 59, sec)
+	assert.Equal(t, hour, min.Hour())
+	assert.Equal(t, minute, min.Minute())
+	assert.Equal(t, sec, min.Second())
+	assert.Equal(t, 1234, NewTime(0, 0, 0, -1234).Nanosecond())
+}
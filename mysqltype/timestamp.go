@@ -0,0 +1,314 @@
+package mysqltype
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"time"
+)
+
+// Timestamp support MySQL Timestamp type
+// https://dev.mysql.com/doc/refman/8.0/en/datetime.html
+// Timestamp is stored as UTC, so Value normalizes it to UTC regardless of its Location.
+// Marshallers also reject times out of TIMESTAMP range, as Value does.
+type Timestamp struct {
+	src time.Time `gorm:"type:timestamp"`
+}
+
+// NewTimestamp Create new Timestamp from time.Date
+func NewTimestamp(year int, month time.Month, day, hour, min, sec, nsec int, loc *time.Location) Timestamp {
+	t := time.Date(year, month, day, hour, min, sec, nsec, loc)
+	return NewTimestampFromTime(t)
+}
+
+// NewTimestampFromTime Create new Timestamp from Time
+func NewTimestampFromTime(t time.Time) Timestamp {
+	return Timestamp{src: t}
+}
+
+// MinTimestamp Minimum Timestamp
+func MinTimestamp() Timestamp {
+	return NewTimestampFromTime(time.Date(1970, 1, 1, 0, 0, 1, 0, time.UTC))
+}
+
+// MaxTimestamp Maximum Timestamp, 2038-01-19 03:14:07.999999 UTC
+// It has the microseconds of TIMESTAMP(6), so truncate it to the seconds to save into TIMESTAMP columns,
+// which MySQL would round up out of range.
+func MaxTimestamp() Timestamp {
+	return NewTimestampFromTime(time.Date(2038, 1, 19, 3, 14, 7, 999999000, time.UTC))
+}
+
+// NowTimestamp Create Now time for MySQL DataBase
+func NowTimestamp() Timestamp {
+	return NewTimestampFromTime(time.Now())
+}
+
+// After behavior as time.Time
+func (dt Timestamp) After(u Timestamp) bool {
+	return dt.src.After(u.src)
+}
+
+// Before behavior as time.Time
+func (dt Timestamp) Before(u Timestamp) bool {
+
+	return dt.src.Before(u.src)
+}
+
+// Equal behavior as time.Time
+func (dt Timestamp) Equal(u Timestamp) bool {
+	return dt.src.Equal(u.src)
+}
+
+// Time convert to time.Time
+func (dt Timestamp) Time() time.Time {
+	return dt.src
+}
+
+// Round behavior as time.Time
+func (dt Timestamp) Round(d time.Duration) Timestamp {
+	return NewTimestampFromTime(dt.src.Round(d))
+}
+
+// UnixNano behavior as time.Time
+func (dt Timestamp) UnixNano() int64 {
+	return dt.src.UnixNano()
+}
+
+// Unix behavior as time.Time
+func (dt Timestamp) Unix() int64 {
+	return dt.src.Unix()
+}
+
+// AddDate behavior as time.Time
+func (dt Timestamp) AddDate(years int, months int, days int) Timestamp {
+	return NewTimestampFromTime(dt.src.AddDate(years, months, days))
+}
+
+// Sub  behavior as time.Time
+func (dt Timestamp) Sub(u Timestamp) time.Duration {
+	return dt.src.Sub(u.src)
+}
+
+// Add behavior as time.Time
+func (dt Timestamp) Add(d time.Duration) Timestamp {
+	return NewTimestampFromTime(dt.src.Add(d))
+}
+
+// Location behavior as time.Time
+func (dt Timestamp) Location() *time.Location {
+	return dt.src.Location()
+}
+
+// Local behavior as time.Time
+func (dt Timestamp) Local() Timestamp {
+	return NewTimestampFromTime(dt.src.Local())
+}
+
+// UTC behavior as time.Time
+func (dt Timestamp) UTC() Timestamp {
+	return NewTimestampFromTime(dt.src.UTC())
+}
+
+// In behavior as time.Time
+func (dt Timestamp) In(loc *time.Location) Timestamp {
+	return NewTimestampFromTime(dt.src.In(loc))
+}
+
+// IsValid reports whether dt is in MySQL TIMESTAMP range
+func (dt Timestamp) IsValid() bool {
+	return !dt.src.Before(MinTimestamp().src) && !dt.src.After(MaxTimestamp().src)
+}
+
+func (dt Timestamp) validate() error {
+	if !dt.IsValid() {
+		return &OutOfRangeError{Value: dt, Min: MinTimestamp(), Max: MaxTimestamp()}
+	}
+	return nil
+}
+
+// IsZero behavior as time.Time
+func (dt Timestamp) IsZero() bool {
+	return dt.src.IsZero()
+}
+
+// Date  behavior as time.Time
+func (dt Timestamp) Date() (year int, month time.Month, day int) {
+	return dt.src.Date()
+}
+
+// Year behavior as time.Time
+func (dt Timestamp) Year() int {
+	return dt.src.Year()
+}
+
+// Month behavior as time.Time
+func (dt Timestamp) Month() time.Month {
+	return dt.src.Month()
+}
+
+// Day behavior as time.Time
+func (dt Timestamp) Day() int {
+	return dt.src.Day()
+}
+
+// Weekday behavior as time.Time
+func (dt Timestamp) Weekday() time.Weekday {
+	return dt.src.Weekday()
+}
+
+// ISOWeek behavior as time.Time
+func (dt Timestamp) ISOWeek() (year int, week int) {
+	return dt.src.ISOWeek()
+}
+
+// Clock behavior as time.Time
+func (dt Timestamp) Clock() (hour, min, sec int) {
+	return dt.src.Clock()
+}
+
+// Hour behavior as time.Time
+func (dt Timestamp) Hour() int {
+	return dt.src.Hour()
+}
+
+// Minute behavior as time.Time
+func (dt Timestamp) Minute() int {
+	return dt.src.Minute()
+}
+
+// Second behavior as time.Time
+func (dt Timestamp) Second() int {
+	return dt.src.Second()
+}
+
+// Nanosecond behavior as time.Time
+func (dt Timestamp) Nanosecond() int {
+	return dt.src.Nanosecond()
+}
+
+// String behavior as time.Time
+func (dt Timestamp) String() string {
+	return dt.src.String()
+}
+
+// Truncate behavior as time.Time
+func (dt Timestamp) Truncate(d time.Duration) Timestamp {
+	return NewTimestampFromTime(dt.src.Truncate(d))
+}
+
+// YearDay behavior as time.Time
+func (dt Timestamp) YearDay() int {
+	return dt.src.YearDay()
+}
+
+// UnmarshalText behavior as time.Time
+// The time is normalized to UTC.
+func (dt *Timestamp) UnmarshalText(text []byte) error {
+	t := time.Time{}
+	if err := t.UnmarshalText(text); err != nil {
+		return err
+	}
+	return dt.setChecked(t)
+}
+
+// MarshalText behavior as time.Time
+func (dt Timestamp) MarshalText() ([]byte, error) {
+	if err := dt.validate(); err != nil {
+		return nil, err
+	}
+	return dt.src.MarshalText()
+}
+
+// UnmarshalBinary behavior as time.Time
+// The time is normalized to UTC.
+func (dt *Timestamp) UnmarshalBinary(data []byte) error {
+	t := time.Time{}
+	if err := t.UnmarshalBinary(data); err != nil {
+		return err
+	}
+	return dt.setChecked(t)
+}
+
+// MarshalBinary behavior as time.Time
+func (dt Timestamp) MarshalBinary() ([]byte, error) {
+	if err := dt.validate(); err != nil {
+		return nil, err
+	}
+	return dt.src.MarshalBinary()
+}
+
+// GobEncode encode as MarshalBinary
+func (dt Timestamp) GobEncode() ([]byte, error) {
+	return dt.MarshalBinary()
+}
+
+// GobDecode decode as UnmarshalBinary
+func (dt *Timestamp) GobDecode(data []byte) error {
+	return dt.UnmarshalBinary(data)
+}
+
+// UnmarshalJSON parse JSON in the format set by SetDateTimeJSONFormat
+// It also accepts RFC 3339 string as time.Time.
+func (dt *Timestamp) UnmarshalJSON(data []byte) error {
+	return dt.unmarshalJSONWith(data, dateTimeJSONFormat)
+}
+
+// MarshalJSON format in the format set by SetDateTimeJSONFormat, RFC 3339 as time.Time by default
+func (dt Timestamp) MarshalJSON() ([]byte, error) {
+	return dt.marshalJSONWith(dateTimeJSONFormat)
+}
+
+func (dt *Timestamp) unmarshalJSONWith(data []byte, f JSONFormat) error {
+	if string(data) == "null" {
+		return nil
+	}
+	t, err := unmarshalJSONTime(data, f, rfc3339JSONFormat{})
+	if err != nil {
+		return err
+	}
+	return dt.setChecked(t)
+}
+
+func (dt Timestamp) marshalJSONWith(f JSONFormat) ([]byte, error) {
+	if err := dt.validate(); err != nil {
+		return nil, err
+	}
+	return f.MarshalJSONTime(dt.src)
+}
+
+var _ driver.Valuer = Timestamp{}
+var _ sql.Scanner = &Timestamp{}
+var _ encoding.TextUnmarshaler = &Timestamp{}
+var _ encoding.TextMarshaler = Timestamp{}
+var _ encoding.BinaryMarshaler = Timestamp{}
+var _ encoding.BinaryUnmarshaler = &Timestamp{}
+var _ json.Marshaler = Timestamp{}
+var _ json.Unmarshaler = &Timestamp{}
+
+// Scan for sql.Scanner
+func (dt *Timestamp) Scan(value interface{}) error {
+	t, err := scanTime(value, "Timestamp", dateTimeLayouts)
+	if err != nil {
+		return err
+	}
+	dt.src = t.UTC()
+	return nil
+}
+
+// Value for driver.Valuer
+func (dt Timestamp) Value() (driver.Value, error) {
+	if err := dt.validate(); err != nil {
+		return nil, err
+	}
+	return dt.src.UTC(), nil
+}
+
+func (dt *Timestamp) setChecked(t time.Time) error {
+	dst := NewTimestampFromTime(t.UTC())
+	if err := dst.validate(); err != nil {
+		return err
+	}
+	*dt = dst
+	return nil
+}
@@ -0,0 +1,151 @@
+package mysqltype
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type TimestampFieldTestStruct struct {
+	ID         int
+	TargetDate Timestamp `gorm:"not null"`
+}
+
+type TimestampNullFieldTestStruct struct {
+	ID         int
+	TargetDate *Timestamp
+}
+
+func TestTimestampField(t *testing.T) {
+	t.Parallel()
+	dateTime := NowTimestamp().Truncate(1 * time.Second)
+	target := &TimestampFieldTestStruct{
+		TargetDate: dateTime,
+	}
+	assert.NoError(t, DB.AutoMigrate(target).Error)
+	assert.NoError(t, DB.Create(target).Error)
+
+	dummy := &TimestampNullFieldTestStruct{}
+
+	assertMySQLErrNumber(t, DB.Table("timestamp_field_test_structs").Create(&dummy).Error, mySQLNullError)
+	dst := &TimestampFieldTestStruct{
+		ID: target.ID,
+	}
+	assert.NoError(t, DB.First(dst).Error)
+	assertTimeEquals(t, target.TargetDate.src, dst.TargetDate.src)
+
+	// the column has no fractional seconds, and MySQL would round MaxTimestamp up out of range
+	assert.NoError(t, DB.Save(&TimestampFieldTestStruct{TargetDate: MaxTimestamp().Truncate(time.Second)}).Error)
+	assert.NoError(t, DB.Save(&TimestampFieldTestStruct{TargetDate: MinTimestamp()}).Error)
+	assert.Error(t, DB.Save(&TimestampFieldTestStruct{TargetDate: MaxTimestamp().AddDate(1, 0, 0)}).Error)
+}
+
+func TestTimestampFieldLocale(t *testing.T) {
+	assert.NoError(t, DB.AutoMigrate(TimestampFieldTestStruct{}).Error)
+	asiaTokyo, err := time.LoadLocation("Asia/Tokyo")
+	assert.NoError(t, err)
+	nowUTC := NowTimestamp().UTC()
+	nowJST := nowUTC.In(asiaTokyo)
+
+	vUTC := TimestampFieldTestStruct{TargetDate: nowUTC}
+	vJST := TimestampFieldTestStruct{TargetDate: nowJST}
+
+	assert.NoError(t, DB.Save(&vUTC).Error)
+	assert.NoError(t, DB.Save(&vJST).Error)
+	dst1 := TimestampFieldTestStruct{ID: vUTC.ID}
+	dst2 := TimestampFieldTestStruct{ID: vJST.ID}
+
+	assert.NoError(t, DB.Find(&dst1).Error)
+	assert.NoError(t, DB.Find(&dst2).Error)
+	assert.Equal(t, dst1.TargetDate, dst2.TargetDate)
+}
+
+func TestTimestampMarshalJSON(t *testing.T) {
+	now := NowTimestamp()
+	expected, err := now.MarshalText()
+	assert.NoError(t, err)
+	actual, err := json.Marshal(now)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "\""+string(expected)+"\"", string(actual))
+}
+
+func TestTimestampValue(t *testing.T) {
+	t.Parallel()
+	dateTime := NowTimestamp()
+	v, err := dateTime.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, dateTime.src.UTC(), v)
+
+	_, err = MinTimestamp().Add(-time.Nanosecond).Value()
+	assertOutOfRange(t, err)
+	_, err = MaxTimestamp().Add(time.Nanosecond).Value()
+	assertOutOfRange(t, err)
+}
+
+func TestTimestampValueNormalizeToUTC(t *testing.T) {
+	t.Parallel()
+	asiaTokyo, err := time.LoadLocation("Asia/Tokyo")
+	assert.NoError(t, err)
+	v, err := NewTimestamp(2018, 8, 20, 9, 0, 0, 0, asiaTokyo).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2018, 8, 20, 0, 0, 0, 0, time.UTC), v)
+}
+
+func TestTimestampScan(t *testing.T) {
+	t.Parallel()
+	target := Timestamp{}
+	now := time.Now()
+	assert.NoError(t, target.Scan(now))
+	assertTimeEquals(t, now, target.src)
+	assert.Equal(t, time.UTC, target.Location())
+	nowStr := now.Format(dateTimeFormatLayout)
+	nowFromFormat, err := time.Parse(dateTimeFormatLayout, nowStr)
+	assert.NoError(t, err)
+	target2 := Timestamp{}
+	assert.NoError(t, target2.Scan([]byte(nowStr)))
+	assertTimeEquals(t, nowFromFormat, target2.src)
+}
+
+func TestTimestampUnmarshalText(t *testing.T) {
+	t.Parallel()
+	asiaTokyo, err := time.LoadLocation("Asia/Tokyo")
+	assert.NoError(t, err)
+	target := Timestamp{}
+	assert.NoError(t, target.UnmarshalText([]byte("2016-12-31T20:02:05.123456+09:00")))
+	assert.Equal(t, time.UTC, target.Location())
+	assert.True(t, target.Equal(NewTimestamp(2016, 12, 31, 20, 2, 5, 123456000, asiaTokyo)))
+
+	assertOutOfRange(t, target.UnmarshalText([]byte("1970-01-01T00:00:00Z")))
+	assertOutOfRange(t, target.UnmarshalText([]byte("2038-01-19T03:14:08Z")))
+}
+
+func TestTimestampUnmarshalBinary(t *testing.T) {
+	t.Parallel()
+	asiaTokyo, err := time.LoadLocation("Asia/Tokyo")
+	assert.NoError(t, err)
+	src := time.Date(2016, 12, 31, 20, 2, 5, 0, asiaTokyo)
+	data, err := src.MarshalBinary()
+	assert.NoError(t, err)
+	target := Timestamp{}
+	assert.NoError(t, target.UnmarshalBinary(data))
+	assert.Equal(t, time.UTC, target.Location())
+	assertTimeEquals(t, src, target.Time())
+
+	_, err = MaxTimestamp().Add(time.Second).MarshalBinary()
+	assertOutOfRange(t, err)
+	data, err = MaxTimestamp().Time().Add(time.Second).MarshalBinary()
+	assert.NoError(t, err)
+	assertOutOfRange(t, target.UnmarshalBinary(data))
+}
+
+func TestTimestampUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+	target := Timestamp{}
+	assert.NoError(t, json.Unmarshal([]byte(`"2016-12-31T20:02:05+09:00"`), &target))
+	assert.Equal(t, time.UTC, target.Location())
+	assertTimeEquals(t, time.Date(2016, 12, 31, 11, 2, 5, 0, time.UTC), target.Time())
+
+	assertOutOfRange(t, json.Unmarshal([]byte(`"2040-01-01T00:00:00Z"`), &target))
+}
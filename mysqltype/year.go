@@ -0,0 +1,250 @@
+package mysqltype
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/binary"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+// Year support MySQL Year type
+// https://dev.mysql.com/doc/refman/8.0/en/year.html
+type Year struct {
+	src int `gorm:"type:year"`
+}
+
+const (
+	minYear = 1901
+	maxYear = 2155
+)
+
+// NewYear Create new Year
+func NewYear(year int) Year {
+	return Year{src: year}
+}
+
+// NewYearFromDate Create new Year from Date
+func NewYearFromDate(d Date) Year {
+	return NewYear(d.Year())
+}
+
+// NewYearFromTime Create new Year from Time
+func NewYearFromTime(t time.Time) Year {
+	return NewYear(t.Year())
+}
+
+// MinYear Minimum Year
+func MinYear() Year {
+	return NewYear(minYear)
+}
+
+// MaxYear Maximum Year
+func MaxYear() Year {
+	return NewYear(maxYear)
+}
+
+// ZeroYear MySQL special value 0000
+func ZeroYear() Year {
+	return Year{}
+}
+
+// NowYear Create Now year for MySQL DataBase
+func NowYear() Year {
+	return NewYearFromTime(time.Now())
+}
+
+// After reports whether y is after u
+func (y Year) After(u Year) bool {
+	return y.src > u.src
+}
+
+// Before reports whether y is before u
+func (y Year) Before(u Year) bool {
+	return y.src < u.src
+}
+
+// Equal reports whether y and u are same year
+func (y Year) Equal(u Year) bool {
+	return y.src == u.src
+}
+
+// Int convert to int
+func (y Year) Int() int {
+	return y.src
+}
+
+// IsZero reports whether y is 0000
+func (y Year) IsZero() bool {
+	return y.src == 0
+}
+
+// IsValid reports whether y is in MySQL YEAR range or 0000
+func (y Year) IsValid() bool {
+	return y.src == 0 || (minYear <= y.src && y.src <= maxYear)
+}
+
+func (y Year) validate() error {
+	if !y.IsValid() {
+		return &OutOfRangeError{Value: y, Min: MinYear(), Max: MaxYear()}
+	}
+	return nil
+}
+
+// AddYears returns y + years
+func (y Year) AddYears(years int) Year {
+	return NewYear(y.src + years)
+}
+
+// FirstDate first day of the year
+func (y Year) FirstDate() Date {
+	return NewDate(y.src, time.January, 1)
+}
+
+// LastDate last day of the year
+func (y Year) LastDate() Date {
+	return NewDate(y.src, time.December, 31)
+}
+
+// String format as number
+func (y Year) String() string {
+	return strconv.Itoa(y.src)
+}
+
+// UnmarshalText parse number
+func (y *Year) UnmarshalText(text []byte) error {
+	v, err := parseYear(string(text))
+	if err != nil {
+		return err
+	}
+	y.src = v
+	return nil
+}
+
+// MarshalText format as number
+func (y Year) MarshalText() ([]byte, error) {
+	if err := y.validate(); err != nil {
+		return nil, err
+	}
+	return []byte(y.String()), nil
+}
+
+const yearBinaryVersion byte = 1
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler
+func (y *Year) UnmarshalBinary(data []byte) error {
+	if len(data) != 3 || data[0] != yearBinaryVersion {
+		return ErrInvalidFormat
+	}
+	v := NewYear(int(binary.BigEndian.Uint16(data[1:])))
+	if err := v.validate(); err != nil {
+		return err
+	}
+	*y = v
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler
+func (y Year) MarshalBinary() ([]byte, error) {
+	if err := y.validate(); err != nil {
+		return nil, err
+	}
+	data := make([]byte, 3)
+	data[0] = yearBinaryVersion
+	binary.BigEndian.PutUint16(data[1:], uint16(y.src))
+	return data, nil
+}
+
+// GobEncode encode as MarshalBinary
+func (y Year) GobEncode() ([]byte, error) {
+	return y.MarshalBinary()
+}
+
+// GobDecode decode as UnmarshalBinary
+func (y *Year) GobDecode(data []byte) error {
+	return y.UnmarshalBinary(data)
+}
+
+// UnmarshalJSON parse JSON number
+// JSON string containing number is also accepted
+func (y *Year) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+	return y.UnmarshalText([]byte(s))
+}
+
+// MarshalJSON format as JSON number
+func (y Year) MarshalJSON() ([]byte, error) {
+	return y.MarshalText()
+}
+
+// GormDataType column type for gorm AutoMigrate
+func (Year) GormDataType(gorm.Dialect) string {
+	return "year"
+}
+
+var _ driver.Valuer = Year{}
+var _ sql.Scanner = &Year{}
+var _ encoding.TextUnmarshaler = &Year{}
+var _ encoding.TextMarshaler = Year{}
+var _ encoding.BinaryMarshaler = Year{}
+var _ encoding.BinaryUnmarshaler = &Year{}
+var _ json.Marshaler = Year{}
+var _ json.Unmarshaler = &Year{}
+
+// Scan for sql.Scanner
+func (y *Year) Scan(value interface{}) error {
+	var v int
+	switch src := value.(type) {
+	case int64:
+		v = int(src)
+		if err := NewYear(v).validate(); err != nil {
+			return err
+		}
+	case []byte:
+		var err error
+		if v, err = parseYear(string(src)); err != nil {
+			return err
+		}
+	case string:
+		var err error
+		if v, err = parseYear(src); err != nil {
+			return err
+		}
+	default:
+		return &InvalidValueTypeError{Value: value, Target: "Year"}
+	}
+	y.src = v
+	return nil
+}
+
+// Value for driver.Valuer
+func (y Year) Value() (driver.Value, error) {
+	if err := y.validate(); err != nil {
+		return nil, err
+	}
+	return int64(y.src), nil
+}
+
+func parseYear(s string) (int, error) {
+	if !isDigits(s) {
+		return 0, ErrInvalidFormat
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, &OutOfRangeError{Value: s, Min: MinYear(), Max: MaxYear()}
+	}
+	if err := NewYear(v).validate(); err != nil {
+		return 0, err
+	}
+	return v, nil
+}
@@ -0,0 +1,138 @@
+package mysqltype
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type YearFieldTestStruct struct {
+	ID         int
+	TargetYear Year `gorm:"not null"`
+}
+
+type YearNullFieldTestStruct struct {
+	ID         int
+	TargetYear *Year
+}
+
+func TestYearField(t *testing.T) {
+	t.Parallel()
+	target := &YearFieldTestStruct{
+		TargetYear: NowYear(),
+	}
+	assert.NoError(t, DB.AutoMigrate(target).Error)
+	assert.NoError(t, DB.Create(target).Error)
+
+	dummy := &YearNullFieldTestStruct{}
+
+	assertMySQLErrNumber(t, DB.Table("year_field_test_structs").Create(&dummy).Error, mySQLNullError)
+	dst := &YearFieldTestStruct{
+		ID: target.ID,
+	}
+	assert.NoError(t, DB.First(dst).Error)
+	assert.Equal(t, target.TargetYear, dst.TargetYear)
+
+	assert.NoError(t, DB.Save(&YearFieldTestStruct{TargetYear: MaxYear()}).Error)
+	assert.NoError(t, DB.Save(&YearFieldTestStruct{TargetYear: MinYear()}).Error)
+	assert.NoError(t, DB.Save(&YearFieldTestStruct{TargetYear: ZeroYear()}).Error)
+	assert.Error(t, DB.Save(&YearFieldTestStruct{TargetYear: NewYear(1900)}).Error)
+}
+
+func TestYearIsValid(t *testing.T) {
+	t.Parallel()
+	assert.True(t, ZeroYear().IsValid())
+	assert.True(t, MinYear().IsValid())
+	assert.True(t, MaxYear().IsValid())
+	assert.False(t, MinYear().AddYears(-1).IsValid())
+	assert.False(t, MaxYear().AddYears(1).IsValid())
+}
+
+func TestYearValue(t *testing.T) {
+	t.Parallel()
+	v, err := NewYear(2018).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2018), v)
+
+	_, err = NewYear(2156).Value()
+	assertOutOfRange(t, err)
+}
+
+func TestYearScan(t *testing.T) {
+	t.Parallel()
+	target := Year{}
+	assert.NoError(t, target.Scan(int64(2018)))
+	assert.Equal(t, NewYear(2018), target)
+	assert.NoError(t, target.Scan([]byte("0000")))
+	assert.Equal(t, ZeroYear(), target)
+	assert.NoError(t, target.Scan("1901"))
+	assert.Equal(t, MinYear(), target)
+
+	assertOutOfRange(t, target.Scan(int64(1900)))
+	assertOutOfRange(t, target.Scan("2156"))
+	assert.Equal(t, ErrInvalidFormat, target.Scan("20a8"))
+	assertInvalidValueType(t, target.Scan(2018.0))
+}
+
+func TestYearMarshalJSON(t *testing.T) {
+	t.Parallel()
+	actual, err := json.Marshal(NewYear(2018))
+	assert.NoError(t, err)
+	assert.Equal(t, "2018", string(actual))
+
+	dst := Year{}
+	assert.NoError(t, json.Unmarshal(actual, &dst))
+	assert.Equal(t, NewYear(2018), dst)
+	assert.NoError(t, json.Unmarshal([]byte(`"2019"`), &dst))
+	assert.Equal(t, NewYear(2019), dst)
+	assert.Error(t, json.Unmarshal([]byte("1800"), &dst))
+
+	_, err = json.Marshal(NewYear(1800))
+	assert.Error(t, err)
+}
+
+func TestYearMarshalText(t *testing.T) {
+	t.Parallel()
+	actual, err := ZeroYear().MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "0", string(actual))
+
+	dst := Year{}
+	assert.NoError(t, dst.UnmarshalText([]byte("2155")))
+	assert.Equal(t, MaxYear(), dst)
+}
+
+func TestYearMarshalBinary(t *testing.T) {
+	t.Parallel()
+	data, err := MaxYear().MarshalBinary()
+	assert.NoError(t, err)
+	dst := Year{}
+	assert.NoError(t, dst.UnmarshalBinary(data))
+	assert.Equal(t, MaxYear(), dst)
+	assert.Equal(t, ErrInvalidFormat, dst.UnmarshalBinary(data[1:]))
+
+	_, err = NewYear(1900).MarshalBinary()
+	assertOutOfRange(t, err)
+}
+
+func TestYearAfterAndBefore(t *testing.T) {
+	t.Parallel()
+	v1 := NewYear(2008)
+	v2 := NewYear(2009)
+
+	assert.True(t, v2.After(v1))
+	assert.False(t, v1.After(v2))
+	assert.True(t, v1.Before(v2))
+	assert.False(t, v2.Before(v1))
+	assert.True(t, v1.Equal(NewYear(2008)))
+}
+
+func TestYearDate(t *testing.T) {
+	t.Parallel()
+	y := NewYear(2016)
+	assert.Equal(t, NewDate(2016, time.January, 1), y.FirstDate())
+	assert.Equal(t, NewDate(2016, time.December, 31), y.LastDate())
+	assert.Equal(t, y, NewYearFromDate(NewDate(2016, time.February, 29)))
+}
@@ -0,0 +1,78 @@
+package mysqltype
+
+import (
+	"strings"
+	"time"
+)
+
+// ZeroDateMode how Scan handles MySQL zero dates
+// https://dev.mysql.com/doc/refman/8.0/en/sql-mode.html#sqlmode_no_zero_date
+type ZeroDateMode int
+
+const (
+	// ZeroDateError Scan returns ErrZeroDate
+	ZeroDateError ZeroDateMode = iota
+	// ZeroDateNull Scan treats zero dates as NULL
+	// Date and DateTime become Go zero value, NullDate and NullDateTime become invalid.
+	ZeroDateNull
+	// ZeroDateSentinel Scan keeps zero dates, so IsMySQLZero reports true
+	ZeroDateSentinel
+)
+
+const (
+	mySQLZeroDate     = "0000-00-00"
+	mySQLZeroDateTime = "0000-00-00 00:00:00"
+)
+
+var zeroDateMode = ZeroDateError
+
+// SetZeroDateMode set how Scan handles MySQL zero dates
+// It is not safe to call concurrently with Scan, so call it on initialization.
+func SetZeroDateMode(mode ZeroDateMode) {
+	zeroDateMode = mode
+}
+
+// MySQLZeroDate MySQL zero date '0000-00-00'
+func MySQLZeroDate() Date {
+	return Date{zero: true}
+}
+
+// MySQLZeroDateTime MySQL zero date '0000-00-00 00:00:00'
+func MySQLZeroDateTime() DateTime {
+	return DateTime{zero: true}
+}
+
+// isMySQLZeroValue reports whether value scanned from driver is zero date
+// The driver returns time.Time{} for zero dates when parseTime=true.
+func isMySQLZeroValue(value interface{}) bool {
+	switch v := value.(type) {
+	case time.Time:
+		return v.IsZero()
+	case []byte:
+		// compare prefix first, since converting non-zero dates to string allocates
+		return len(v) >= len(mySQLZeroDate) && string(v[:len(mySQLZeroDate)]) == mySQLZeroDate && isMySQLZeroLiteral(string(v))
+	case string:
+		return isMySQLZeroLiteral(v)
+	}
+	return false
+}
+
+// isMySQLZeroLiteral reports whether s is '0000-00-00' or '0000-00-00 00:00:00[.000000]'
+func isMySQLZeroLiteral(s string) bool {
+	if s == mySQLZeroDate || s == mySQLZeroDateTime {
+		return true
+	}
+	return strings.HasPrefix(s, mySQLZeroDateTime+".") && strings.Trim(s[len(mySQLZeroDateTime)+1:], "0") == ""
+}
+
+// scanMySQLZero reports whether zero date should be kept as sentinel
+func scanMySQLZero() (sentinel bool, err error) {
+	switch zeroDateMode {
+	case ZeroDateNull:
+		return false, nil
+	case ZeroDateSentinel:
+		return true, nil
+	default:
+		return false, ErrZeroDate
+	}
+}
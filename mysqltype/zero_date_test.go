@@ -0,0 +1,152 @@
+package mysqltype
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type ZeroDateFieldTestStruct struct {
+	ID             int
+	TargetDate     Date
+	TargetDateTime DateTime
+}
+
+func TestZeroDateField(t *testing.T) {
+	defer SetZeroDateMode(ZeroDateError)
+	SetZeroDateMode(ZeroDateSentinel)
+
+	assert.NoError(t, DB.AutoMigrate(&ZeroDateFieldTestStruct{}).Error)
+	tx := DB.Begin()
+	defer tx.Rollback()
+	defer tx.Exec("SET SESSION sql_mode = DEFAULT")
+	assert.NoError(t, tx.Exec("SET SESSION sql_mode = ''").Error)
+
+	target := &ZeroDateFieldTestStruct{
+		TargetDate:     MySQLZeroDate(),
+		TargetDateTime: MySQLZeroDateTime(),
+	}
+	assert.NoError(t, tx.Create(target).Error)
+	dst := &ZeroDateFieldTestStruct{ID: target.ID}
+	assert.NoError(t, tx.First(dst).Error)
+	assert.True(t, dst.TargetDate.IsMySQLZero())
+	assert.True(t, dst.TargetDateTime.IsMySQLZero())
+}
+
+func TestZeroDateScan(t *testing.T) {
+	defer SetZeroDateMode(ZeroDateError)
+	values := []interface{}{
+		time.Time{},
+		[]byte("0000-00-00"),
+		"0000-00-00 00:00:00",
+		[]byte("0000-00-00 00:00:00.000000"),
+	}
+
+	for _, v := range values {
+		SetZeroDateMode(ZeroDateError)
+		d := NowDate()
+		assert.Equal(t, ErrZeroDate, d.Scan(v))
+		dt := NowDateTime()
+		assert.Equal(t, ErrZeroDate, dt.Scan(v))
+		nd := NullDate{}
+		assert.Equal(t, ErrZeroDate, nd.Scan(v))
+
+		SetZeroDateMode(ZeroDateNull)
+		assert.NoError(t, d.Scan(v))
+		assert.Equal(t, Date{}, d)
+		assert.NoError(t, dt.Scan(v))
+		assert.Equal(t, DateTime{}, dt)
+		nd = NewNullDate(NowDate())
+		assert.NoError(t, nd.Scan(v))
+		assert.False(t, nd.Valid)
+		ndt := NewNullDateTime(NowDateTime())
+		assert.NoError(t, ndt.Scan(v))
+		assert.False(t, ndt.Valid)
+
+		SetZeroDateMode(ZeroDateSentinel)
+		assert.NoError(t, d.Scan(v))
+		assert.True(t, d.IsMySQLZero())
+		assert.NoError(t, dt.Scan(v))
+		assert.True(t, dt.IsMySQLZero())
+		assert.NoError(t, nd.Scan(v))
+		assert.True(t, nd.Valid)
+		assert.True(t, nd.Date.IsMySQLZero())
+	}
+
+	SetZeroDateMode(ZeroDateSentinel)
+	d := MySQLZeroDate()
+	assert.NoError(t, d.Scan([]byte("2018-08-20")))
+	assert.False(t, d.IsMySQLZero())
+	assert.Error(t, d.Scan([]byte("0000-00-00 00:00:01")))
+}
+
+func TestZeroDateValue(t *testing.T) {
+	t.Parallel()
+	v, err := MySQLZeroDate().Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "0000-00-00", v)
+
+	v, err = MySQLZeroDateTime().Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "0000-00-00 00:00:00", v)
+
+	v, err = NewNullDate(MySQLZeroDate()).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "0000-00-00", v)
+}
+
+func TestZeroDateEqual(t *testing.T) {
+	t.Parallel()
+	assert.True(t, MySQLZeroDate().Equal(MySQLZeroDate()))
+	assert.False(t, MySQLZeroDate().Equal(Date{}))
+	assert.True(t, MySQLZeroDateTime().Equal(MySQLZeroDateTime()))
+	assert.False(t, MySQLZeroDateTime().Equal(DateTime{}))
+	assert.False(t, Date{}.IsMySQLZero())
+	assert.False(t, DateTime{}.IsMySQLZero())
+}
+
+func TestZeroDateMarshalJSON(t *testing.T) {
+	t.Parallel()
+	zero := MySQLZeroDate()
+	actual, err := json.Marshal(zero)
+	assert.NoError(t, err)
+	assert.Equal(t, `"0000-00-00"`, string(actual))
+	d := NowDate()
+	assert.NoError(t, json.Unmarshal(actual, &d))
+	assert.True(t, d.IsMySQLZero())
+
+	actual, err = json.Marshal(MySQLZeroDateTime())
+	assert.NoError(t, err)
+	assert.Equal(t, `"0000-00-00 00:00:00"`, string(actual))
+	dt := NowDateTime()
+	assert.NoError(t, json.Unmarshal(actual, &dt))
+	assert.True(t, dt.IsMySQLZero())
+}
+
+func TestZeroDateMarshalText(t *testing.T) {
+	t.Parallel()
+	actual, err := MySQLZeroDate().MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "0000-00-00", string(actual))
+	d := Date{}
+	assert.NoError(t, d.UnmarshalText(actual))
+	assert.True(t, d.IsMySQLZero())
+	assert.Equal(t, "0000-00-00", d.String())
+}
+
+func TestZeroDateMarshalBinary(t *testing.T) {
+	t.Parallel()
+	actual, err := MySQLZeroDate().MarshalBinary()
+	assert.NoError(t, err)
+	d := NowDate()
+	assert.NoError(t, d.UnmarshalBinary(actual))
+	assert.True(t, d.IsMySQLZero())
+
+	actual, err = MySQLZeroDateTime().MarshalBinary()
+	assert.NoError(t, err)
+	dt := NowDateTime()
+	assert.NoError(t, dt.UnmarshalBinary(actual))
+	assert.True(t, dt.IsMySQLZero())
+}